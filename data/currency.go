@@ -0,0 +1,33 @@
+package data
+
+import "strings"
+
+// currencyMinorUnits of the active ISO-4217 currency codes.
+// map[code]digits ; count of the fractional part digits.
+var currencyMinorUnits = func() map[string]uint32 {
+	units := make(map[string]uint32, 180)
+	for digits, codes := range []string{
+		0: "BIF CLP DJF GNF ISK JPY KMF KRW PYG RWF UGX UYI VND VUV XAF XOF XPF",
+		2: "AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BMD BND BOB BOV BRL BSD BTN BWP BYN BZD " +
+			"CAD CDF CHE CHF CHW CNY COP COU CRC CUC CUP CVE CZK DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL " +
+			"GHS GIP GMD GTQ GYD HKD HNL HTG HUF IDR ILS INR IRR JMD KES KGS KHR KPW KYD KZT LAK LBP LKR LRD " +
+			"LSL MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD PAB PEN " +
+			"PGK PHP PKR PLN QAR RON RSD RUB SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL " +
+			"THB TJS TMT TOP TRY TTD TWD TZS UAH USD USN UYU UZS VED VES WST XCD XCG YER ZAR ZMW ZWG ZWL",
+		3: "BHD IQD JOD KWD LYD OMR TND",
+		4: "CLF UYW",
+	} {
+		for _, code := range strings.Fields(codes) {
+			units[code] = uint32(digits)
+		}
+	}
+	return units
+}()
+
+// CurrencyMinorUnits returns the count of the fractional part digits
+// of the ISO-4217 currency [code], e.g.: 2 for "USD", 0 for "JPY".
+// The [ok] indicates whether the currency [code] is known.
+func CurrencyMinorUnits(code string) (digits uint32, ok bool) {
+	digits, ok = currencyMinorUnits[strings.ToUpper(code)]
+	return // digits, ok
+}
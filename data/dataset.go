@@ -1,8 +1,11 @@
 package data
 
 import (
-	"errors"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/webitel/custom/internal/pragma"
 	customrel "github.com/webitel/custom/reflect"
@@ -12,10 +15,11 @@ import (
 
 // dataset base composite type descriptor
 type dataset struct {
-	dc     int64
-	err    error // once: integrity error
-	spec   *custompb.Dataset
-	fields Fields
+	dc      int64
+	err     error // once: integrity error
+	spec    *custompb.Dataset
+	fields  Fields
+	indices Indices
 }
 
 func datasetAs(dc int64, spec *custompb.Dataset) *dataset {
@@ -29,6 +33,10 @@ func datasetAs(dc int64, spec *custompb.Dataset) *dataset {
 	ds.fields = newFieldDescriptors(
 		0, ds, ds.spec.Fields,
 	)
+	// init indices
+	ds.indices = newIndexDescriptors(
+		ds, ds.spec.GetIndices(),
+	)
 	return ds
 }
 
@@ -41,49 +49,132 @@ func (ds *dataset) Dc() int64 {
 
 // Err to check the integrity
 // of the data type structure.
+//
+// Returns [Violations] list of all the structure integrity rules violated.
 func (ds *dataset) Err() error {
 	if ds == nil {
 		// Not Found
+		return newViolation("", "custom.dataset.type.required", "dataset type required but missing")
 	}
 	if ds.err != nil {
 		// once: critical
 		return ds.err
 	}
+	errs := ds.validate()
+	if errs != nil {
+		// once: failed !
+		ds.err = errs
+	}
+	return errs
+}
+
+// dataset name syntax
+var (
+	datasetNameRegexp = regexp.MustCompile(`^[a-z][0-9a-z_]*$`)
+	// [see]: store/postgres.CustomSqlIdentifier(..)
+	fieldNameRegexp = regexp.MustCompile(`^[A-Za-z_][0-9A-Za-z_]*$`)
+)
+
+// primaryKinds are indexable [primary] key data kinds
+var primaryKinds = []customrel.Kind{
+	customrel.INT, customrel.INT32, customrel.INT64,
+	customrel.UINT, customrel.UINT32, customrel.UINT64,
+	customrel.STRING,
+}
+
+// validate dataset structure integrity
+func (ds *dataset) validate() error {
 	var (
-		errs, err error
-		withErr   = func(err error) {
-			errs = errors.Join(errs, err)
+		errs      Violations
+		violation = func(field, rule, format string, args ...any) {
+			errs = append(errs, newViolation(field, rule, format, args...))
 		}
 	)
-	defer func() {
-		if errs != nil {
-			// once: failed !
-			ds.err = err
-		}
-	}()
 	if ds.spec == nil {
 		// Not Found
+		violation("", "custom.dataset.type.required", "dataset type required but missing")
+		return errs.Err()
+	}
+	// [CHECK] name
+	name := ds.Name()
+	if name == "" {
+		violation("repo", "custom.dataset.name.required", "name required but missing")
+	} else if !datasetNameRegexp.MatchString(name) {
+		violation("repo", "custom.dataset.name.invalid", "name( %s ); invalid syntax", name)
+	}
+	// [CHECK] path
+	if pkg := ds.Path(); pkg == "" {
+		violation("path", "custom.dataset.path.required", "path required but missing")
+	} else {
+		dir, base := path.Split(pkg)
+		dir = strings.TrimSuffix(dir, "/")
+		for _, dn := range strings.Split(pkg, "/") {
+			if !datasetNameRegexp.MatchString(dn) {
+				violation("path", "custom.dataset.path.invalid", "path( %s ); invalid syntax", pkg)
+				dir, base = "", name // reported
+				break
+			}
+		}
+		if base != name {
+			violation("path", "custom.dataset.path.invalid", "path( %s ); name( %s ) mismatch", pkg, name)
+		} else if ds.dc > 0 && dir != DictionariesDir && dir != ExtensionsDir {
+			// [ CUSTOM ] dataset(s) location
+			violation("path", "custom.dataset.path.invalid", "path( %s ); %s/ or %s/ directory expected", pkg, DictionariesDir, ExtensionsDir)
+		}
 	}
-	// [TODO]: Validate !
-	// [CHECK]:
-	// - name
-	// - path
-	// - fields
-	// - indices
-	// - [primary] ?
-	// - [display] ?
 
 	// [CHECK] fields
-	fields := ds.Fields()
+	errs = append(errs, fieldsViolations(ds.dc, ds.Fields(), "fields")...)
+
+	// [CHECK] [primary]
+	if primary := ds.spec.GetPrimary(); primary == "" {
+		violation("primary", "custom.dataset.primary.required", "primary field required but missing")
+	} else if fd := ds.Primary(); fd == nil {
+		violation("primary", "custom.dataset.primary.not_found", "primary( %s ); no such field", primary)
+	} else if kind := fd.Type().Kind(); !slices.Contains(primaryKinds, kind) {
+		violation("primary", "custom.dataset.primary.not_indexable", "primary( %s ); %s kind is not indexable", primary, kind)
+	}
+	// [CHECK] [display]
+	if display := ds.spec.GetDisplay(); display == "" {
+		violation("display", "custom.dataset.display.required", "display field required but missing")
+	} else if fd := ds.Display(); fd == nil {
+		violation("display", "custom.dataset.display.not_found", "display( %s ); no such field", display)
+	} else if fd.Kind() == customrel.LIST {
+		violation("display", "custom.dataset.display.invalid", "display( %s ); list kind is not allowed", display)
+	}
+
+	// [CHECK] indices
+	errs = append(errs, ds.indices.violations()...)
+	return errs.Err()
+}
+
+// fieldsViolations of the [fields] structure integrity,
+// reported within the [elem] specification path, e.g.: "fields".
+func fieldsViolations(dc int64, fields customrel.FieldDescriptors, elem string) (errs Violations) {
+	violation := func(field, rule, format string, args ...any) {
+		errs = append(errs, newViolation(field, rule, format, args...))
+	}
+	if fields.Num() == 0 {
+		violation(elem, "custom.dataset.fields.required", "fields required but missing")
+	}
 	fields.Range(func(fd customrel.FieldDescriptor) bool {
-		name := fd.Name() // ^\w+$
+		var (
+			name = fd.Name()
+			elem = fmt.Sprintf("%s[%d]", elem, fd.Num()-1)
+		)
+		if name == "" {
+			violation(elem+".id", "custom.dataset.field.name.required", "fields( name: ); required but missing")
+			return true // next
+		}
+		if dc > 0 && !fieldNameRegexp.MatchString(name) {
+			violation(elem+".id", "custom.dataset.field.name.invalid", "fields( name: %s ); invalid syntax", name)
+		}
 		// IS UNIQUE [name] ?!
 		if num := fd.Num(); num > 1 {
 			// ordered: [ 1, 2, 3, .. ]
 			fields.Range(func(fx customrel.FieldDescriptor) bool {
-				// if CaseIgnoreMatch(fd.GetId(), e.GetId()) {
-				if name == fx.Name() {
-					withErr(fmt.Errorf("fields( name: %s ); duplicate", name))
+				if strings.EqualFold(name, fx.Name()) {
+					violation(elem+".id", "custom.dataset.field.name.duplicate", "fields( name: %s ); duplicate", name)
 					return false
 				}
 				// check previous fields only !
@@ -92,13 +183,32 @@ func (ds *dataset) Err() error {
 		}
 		// field.kind
 		// field.type
-		if err = fd.Type().Err(); err != nil {
-			withErr(fmt.Errorf("fields( name: %s ); %v", name, err))
+		rtyp := fd.Type()
+		if nested, is := Elem(rtyp).(*Struct); is {
+			// [RECORD] nested structure
+			errs = append(errs, fieldsViolations(dc, nested.Fields(), elem+".record.fields")...)
+		} else if err := rtyp.Err(); err != nil {
+			if ref, is := Elem(rtyp).(*Lookup); is && ref.Dictionary() == nil {
+				violation(elem+".lookup", "custom.dataset.field.lookup.not_found", "fields( name: %s ); %v", name, err)
+			} else {
+				violation(elem+".type", "custom.dataset.field.type.invalid", "fields( name: %s ); %v", name, err)
+			}
+		}
+		// field.lookup.query ; template(s) of the same record field(s)
+		if ref, is := Elem(rtyp).(*Lookup); is {
+			for _, filter := range ref.queryFields() {
+				RangeTemplate(ref.Query()[filter], func(part string, param bool) bool {
+					if param && fields.ByName(part) == nil {
+						violation(elem+".lookup.query", "custom.dataset.field.lookup.query.invalid", "fields( name: %s ).query( %s ); %s no such field", name, filter, part)
+					}
+					return true
+				})
+			}
 		}
 		// field.value; default
-		return true // (err == nil)
+		return true // next
 	})
-	return errs
+	return // errs
 }
 
 // Name of the dataset type.
@@ -141,7 +251,7 @@ func (ds *dataset) Display() customrel.FieldDescriptor {
 
 // Dataset indexing
 func (ds *dataset) Indices() customrel.IndexDescriptors {
-	panic("not implemented") // TODO: Implement
+	return &ds.indices
 }
 
 // IsReadonly reports whether this is [ GLOBAL ] type descriptor.
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestDatasetErr(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *custompb.Dataset
+		want []string // violated rule(s)
+	}{
+		{
+			name: "valid",
+			spec: &custompb.Dataset{
+				Repo: "cities", Path: "dictionaries/cities",
+				Primary: "id", Display: "name",
+				Fields: []*custompb.Field{
+					{Id: "id", Kind: datapb.Kind_int64},
+					{Id: "name", Kind: datapb.Kind_string},
+				},
+			},
+		},
+		{
+			name: "invalid",
+			spec: &custompb.Dataset{
+				Repo: "Cities", Path: "cities",
+				Primary: "name", Display: "tags",
+				Fields: []*custompb.Field{
+					{Id: "id", Kind: datapb.Kind_int64},
+					{Id: "name", Kind: datapb.Kind_float},
+					{Id: "Name", Kind: datapb.Kind_string},
+					{Id: "tags", Kind: datapb.Kind_list, Type: &custompb.Field_String_{String_: &datapb.Text{}}},
+				},
+				Indices: map[string]*custompb.Index{
+					"code": {Fields: []string{"code"}},
+				},
+			},
+			want: []string{
+				"custom.dataset.name.invalid",
+				"custom.dataset.path.invalid",
+				"custom.dataset.field.name.duplicate",
+				"custom.dataset.primary.not_indexable",
+				"custom.dataset.display.invalid",
+				"custom.dataset.index.field.not_found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DictionaryOf(1, tt.spec).Err()
+			var errs Violations
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("Err() = %T, want Violations", err)
+			}
+			got := make([]string, len(errs))
+			for i, v := range errs {
+				got[i] = v.Rule
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Err() rules = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package data
+
+import (
+	"strings"
+	"time"
+
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Field value expression(s) well-known placeholders.
+const (
+	// ValueTimestamp of the current time.
+	ValueTimestamp = "$(timestamp)"
+	// ValueUser reference of the current user.
+	ValueUser = "$(user)"
+	// ValueNextval of the field's sequence.
+	ValueNextval = "$(nextval)"
+)
+
+// Evaluator of the field [default] and [always] value expressions.
+//
+// The zero value is ready to use with the system clock,
+// with no current user and no sequence source available.
+type Evaluator struct {
+	// Clock source of the $(timestamp) value.
+	// Default: time.Now
+	Clock func() time.Time
+	// User source of the $(user) reference.
+	// Evaluates to NULL if not defined.
+	User func() *custompb.Lookup
+	// Sequence source of the $(nextval) value for given field.
+	// If not defined, the value left unset,
+	// so the storage MAY generate it on it's own.
+	Sequence func(fd customrel.FieldDescriptor) (any, error)
+}
+
+// fieldValueOf returns [fd] field value [expr]ession spec,
+// and whether it's evaluated on every write.
+func fieldValueOf(fd customrel.FieldDescriptor) (expr *structpb.Value, always bool) {
+	var spec *custompb.Field
+	if is, ok := fd.(*Field); ok {
+		spec = is.spec // fast path
+	} else {
+		spec = fd.Descriptor()
+	}
+	switch v := spec.GetValue().(type) {
+	case *custompb.Field_Default:
+		return v.Default, false
+	case *custompb.Field_Always:
+		return v.Always, true
+	}
+	return nil, false
+}
+
+// evaluation context ; once per record
+type evaluation struct {
+	*Evaluator
+	now  time.Time
+	user *custompb.Lookup
+}
+
+func (c *Evaluator) begin() *evaluation {
+	ctx := &evaluation{Evaluator: c}
+	if c.Clock != nil {
+		ctx.now = c.Clock()
+	} else {
+		ctx.now = time.Now()
+	}
+	if c.User != nil {
+		ctx.user = c.User()
+	}
+	return ctx
+}
+
+// eval returns the [fd] field [expr]ession value.
+// The [ok] is false when the value is to be left unset.
+func (c *evaluation) eval(fd customrel.FieldDescriptor, expr *structpb.Value) (value any, ok bool, err error) {
+	text, is := expr.GetKind().(*structpb.Value_StringValue)
+	if !is || !strings.HasPrefix(text.StringValue, "$(") {
+		// constant value
+		return expr, true, nil
+	}
+	switch strings.TrimSpace(text.StringValue) {
+	case ValueTimestamp:
+		return c.now, true, nil
+	case ValueUser:
+		if c.user == nil {
+			return nil, true, nil // NULL
+		}
+		return c.user, true, nil
+	case ValueNextval:
+		if c.Sequence == nil {
+			return nil, false, nil // [ STORAGE ] generated
+		}
+		value, err = c.Sequence(fd)
+		return value, (err == nil), err
+	}
+	return nil, false, RequestError(
+		"custom.field.default.invalid",
+		"custom: field( %s ) value %s; unknown expression",
+		fd.Name(), text.StringValue,
+	)
+}
+
+// apply evaluates [rec]ord fields value expressions.
+// The [insert] indicates whether [default] values are to be applied.
+func (c *Evaluator) apply(rec *Record, insert bool) error {
+	var (
+		err    error
+		ctx    = c.begin()
+		fields = rec.Dataset().Fields()
+		// populated explicitly
+		set = make(map[string]bool)
+	)
+	for _, name := range rec.Fields() {
+		set[name] = true
+	}
+	// [NOTE] generated value(s) are system writes !
+	if mode := rec.Mode(); mode != WriteSystem {
+		rec.BeginUpdate(WriteSystem)
+		defer rec.BeginUpdate(mode)
+	}
+	fields.Range(func(fd customrel.FieldDescriptor) bool {
+		expr, always := fieldValueOf(fd)
+		if expr == nil {
+			return true // next
+		}
+		if !always && (!insert || set[fd.Name()]) {
+			// [default] on insert, when omitted ONLY !
+			return true // next
+		}
+		value, ok, re := ctx.eval(fd, expr)
+		if err = re; err != nil || !ok {
+			return (err == nil) // next
+		}
+		err = rec.Set(fd, value)
+		if err != nil {
+			err = RequestError(
+				"custom.field.default.invalid",
+				"custom: field( %s ) value; %v",
+				fd.Name(), err,
+			)
+		}
+		return (err == nil) // next
+	})
+	return err
+}
+
+// Insert applies [default] value(s) of the [rec]ord field(s) omitted
+// and [always] value(s) of all the field(s) ; new record.
+func (c *Evaluator) Insert(rec *Record) error {
+	return c.apply(rec, true)
+}
+
+// Update applies [always] value(s) of all the [rec]ord field(s) ; modified record.
+func (c *Evaluator) Update(rec *Record) error {
+	return c.apply(rec, false)
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestEvaluator(t *testing.T) {
+	cities, err := NewDictionary(1, &custompb.InputDictionary{
+		Name: "cities",
+		Fields: []*custompb.Field{
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "code", Kind: datapb.Kind_string, Value: &custompb.Field_Default{
+				Default: structpb.NewStringValue("UA"),
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewDictionary() error = %v", err)
+	}
+
+	var (
+		now  = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		user = &custompb.Lookup{Id: "7", Name: "admin"}
+		eval = Evaluator{
+			Clock: func() time.Time { return now },
+			User:  func() *custompb.Lookup { return user },
+		}
+		fields = cities.Fields()
+		get    = func(rec *Record, name string) any {
+			return rec.Get(fields.ByName(name))
+		}
+	)
+
+	rec := NewRecord(cities)
+	_ = rec.Set(fields.ByName("name"), "Kyiv")
+	if err = eval.Insert(rec); err != nil {
+		t.Fatalf("Insert() error = %v", err)
+	}
+	if v := deref(get(rec, "code").(*string)); v != "UA" {
+		t.Errorf("Insert() code = %q, want %q", v, "UA")
+	}
+	for _, name := range []string{"created_at", "updated_at"} {
+		if v, _ := get(rec, name).(*time.Time); v == nil || !v.Equal(now) {
+			t.Errorf("Insert() %s = %v, want %v", name, v, now)
+		}
+	}
+	for _, name := range []string{"created_by", "updated_by"} {
+		if v, _ := get(rec, name).(*custompb.Lookup); v.GetId() != user.Id {
+			t.Errorf("Insert() %s = %v, want %v", name, v, user)
+		}
+	}
+
+	now = now.Add(time.Hour)
+	rec = NewRecord(cities)
+	if err = eval.Update(rec); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if v := get(rec, "created_at"); v != nil {
+		t.Errorf("Update() created_at = %v, want unset", v)
+	}
+	if v, _ := get(rec, "updated_at").(*time.Time); v == nil || !v.Equal(now) {
+		t.Errorf("Update() updated_at = %v, want %v", v, now)
+	}
+}
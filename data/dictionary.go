@@ -1,8 +1,13 @@
 package data
 
 import (
+	"path"
+	"slices"
+	"strings"
+
 	customrel "github.com/webitel/custom/reflect"
 	custompb "github.com/webitel/proto/gen/custom"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -21,9 +26,101 @@ func DictionaryOf(dc int64, spec *custompb.Dataset) Dictionary {
 
 // NewDictionary returns [custom/reflect.DictionaryDescriptor]
 // for given [*custompb.InputDictionary] type specification.
+//
+// The [name] is normalized and the [path] is derived as `dictionaries/<name>`.
+// Well-known system fields, e.g.: [id], [created_at], [updated_by], are injected
+// unless declared, so the [primary] key defaults to the [id] field.
 func NewDictionary(dc int64, spec *custompb.InputDictionary) (Dictionary, error) {
-	panic("not implemented")
-	// return Dictionary{datasetOf(dc, spec)}
+	if dc < 1 {
+		return Dictionary{}, RequestError(
+			"custom.dictionary.dc.required",
+			"custom: dictionary domain required but missing",
+		)
+	}
+	name := normalizeName(
+		strings.TrimPrefix(spec.GetName(), DictionariesDir+"/"),
+	)
+	desc := &custompb.Dataset{
+		Repo:    name,
+		Path:    path.Join(DictionariesDir, name),
+		Name:    strings.TrimSpace(spec.GetTitle()),
+		About:   strings.TrimSpace(spec.GetAbout()),
+		Primary: strings.TrimSpace(spec.GetPrimary()),
+		Display: strings.TrimSpace(spec.GetDisplay()),
+		Indices: spec.GetIndices(),
+	}
+	if desc.Name == "" {
+		desc.Name = name // default
+	}
+	var (
+		fields = spec.GetFields()
+		system = []*custompb.Field{
+			&fieldCreatedAt,
+			&fieldCreatedBy,
+			&fieldUpdatedAt,
+			&fieldUpdatedBy,
+		}
+	)
+	desc.Fields = make([]*custompb.Field, 0, 1+len(fields)+len(system))
+	// declared ?
+	declared := func(name string) bool {
+		for _, field := range desc.Fields {
+			if strings.EqualFold(field.GetId(), name) {
+				return true
+			}
+		}
+		return false
+	}
+	// [primary] ; default
+	if desc.Primary == "" {
+		desc.Primary = fieldSerialId.GetId()
+	}
+	for _, field := range fields {
+		field = proto.Clone(field).(*custompb.Field)
+		field.Id = strings.TrimSpace(field.GetId())
+		desc.Fields = append(desc.Fields, field)
+	}
+	if !declared(desc.Primary) && strings.EqualFold(desc.Primary, fieldSerialId.GetId()) {
+		// [ id ] ; as the first one !
+		desc.Fields = slices.Insert(desc.Fields, 0,
+			proto.Clone(&fieldSerialId).(*custompb.Field),
+		)
+	}
+	// [display] ; default
+	if desc.Display == "" {
+		desc.Display = desc.Primary
+		for _, field := range fields {
+			_, text := field.GetType().(*custompb.Field_String_)
+			// [NOTE] NOT a [list] of [string] ; kind: list
+			if kind := field.GetKind(); kind == customrel.STRING || (kind == customrel.NONE && text) {
+				// the first [string] field declared
+				desc.Display = strings.TrimSpace(field.GetId())
+				break
+			}
+		}
+	}
+	for _, field := range system {
+		if !declared(field.GetId()) {
+			desc.Fields = append(desc.Fields,
+				proto.Clone(field).(*custompb.Field),
+			)
+		}
+	}
+	dict := DictionaryOf(dc, desc)
+	// [CHECK] name, path, field(s) ; see dataset.validate
+	if err := joinRequestError(
+		"custom.dictionary.invalid",
+		("custom: " + desc.Path), dict.Err(),
+	); err != nil {
+		return dict, err
+	}
+	return dict, nil
+}
+
+// normalizeName returns the dataset [name] in it's canonical form.
+// e.g.: " Cities " => "cities"
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
 }
 
 var _ customrel.DictionaryDescriptor = Dictionary{}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestNewDictionary(t *testing.T) {
+	tests := []struct {
+		name string
+		dc   int64
+		spec *custompb.InputDictionary
+		want string // error id ; if any
+	}{
+		{
+			name: "valid",
+			dc:   1,
+			spec: &custompb.InputDictionary{
+				Name: " Cities ",
+				Fields: []*custompb.Field{
+					{Id: " code ", Kind: datapb.Kind_int32},
+					{Id: "tags", Kind: datapb.Kind_list, Type: &custompb.Field_String_{String_: &datapb.Text{}}},
+					{Id: "name", Kind: datapb.Kind_string},
+				},
+			},
+		},
+		{
+			name: "domain",
+			spec: &custompb.InputDictionary{Name: "cities"},
+			want: "custom.dictionary.dc.required",
+		},
+		{
+			name: "name.required",
+			dc:   1,
+			spec: &custompb.InputDictionary{Name: " "},
+			want: "custom.dictionary.invalid",
+		},
+		{
+			name: "name.invalid",
+			dc:   1,
+			spec: &custompb.InputDictionary{Name: "1st-cities"},
+			want: "custom.dictionary.invalid",
+		},
+		{
+			name: "structure",
+			dc:   1,
+			spec: &custompb.InputDictionary{
+				Name:    "cities",
+				Display: "title",
+				Fields: []*custompb.Field{
+					{Id: "name", Kind: datapb.Kind_string},
+				},
+			},
+			want: "custom.dictionary.invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dict, err := NewDictionary(tt.dc, tt.spec)
+			if tt.want != "" {
+				var re *Error
+				if !errors.As(err, &re) || re.Id != tt.want {
+					t.Fatalf("NewDictionary() error = %v, want %s", err, tt.want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewDictionary() error = %v", err)
+			}
+			if dict.Path() != "dictionaries/cities" || dict.Name() != "cities" {
+				t.Errorf("NewDictionary() path = %s, want dictionaries/cities", dict.Path())
+			}
+			// [ id ] primary ; injected as the first one
+			if dict.Primary().Name() != "id" || dict.Fields().Get(0).Name() != "id" {
+				t.Errorf("NewDictionary() primary = %s, want id", dict.Primary().Name())
+			}
+			// the first [string] field declared ; NOT a [list]
+			if dict.Display().Name() != "name" {
+				t.Errorf("NewDictionary() display = %s, want name", dict.Display().Name())
+			}
+			var got []string
+			dict.Fields().Range(func(fd customrel.FieldDescriptor) bool {
+				got = append(got, fd.Name())
+				return true
+			})
+			want := []string{"id", "code", "tags", "name", "created_at", "created_by", "updated_at", "updated_by"}
+			if !slices.Equal(got, want) {
+				t.Errorf("NewDictionary() fields = %q, want %q", got, want)
+			}
+		})
+	}
+}
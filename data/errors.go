@@ -1,12 +1,17 @@
 package data
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Error struct {
 	Id      string
 	Code    int
 	Status  string
 	Message string
+	// template of the constraint violation message, if any
+	tmpl *violationTemplate
 }
 
 func (e *Error) Error() string {
@@ -30,3 +35,37 @@ func ConflictError(id, format string, args ...any) *Error {
 		Message: fmt.Sprintf(format, args...),
 	}
 }
+
+func NotFoundError(id, format string, args ...any) *Error {
+	return &Error{
+		Id:      id,
+		Code:    404,
+		Status:  "Not Found",
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// joinRequestError returns single [RequestError] of the [id] kind
+// with all the [errs] messages aggregated, or nil if there are none.
+func joinRequestError(id, prefix string, errs ...error) *Error {
+	var text []string
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		// errors.Join(..) ; unwrap
+		if join, is := err.(interface{ Unwrap() []error }); is {
+			for _, err := range join.Unwrap() {
+				text = append(text, err.Error())
+			}
+			continue
+		}
+		text = append(text, err.Error())
+	}
+	if len(text) == 0 {
+		return nil
+	}
+	return RequestError(
+		id, "%s; %s", prefix, strings.Join(text, "; "),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strings"
 
 	"github.com/webitel/custom/internal/pragma"
 	customrel "github.com/webitel/custom/reflect"
@@ -62,9 +63,51 @@ func ExtensionOf(dc int64, spec *custompb.Dataset) *Extension {
 	}
 }
 
-// NewExtension specification
+// NewExtension specification.
+//
+// The [path] of the base [ GLOBAL & extendable ] dictionary is normalized
+// and resolved, so the extension path is derived as `extensions/<name>`.
 func NewExtension(dc int64, spec *custompb.InputExtension) (*Extension, error) {
-	panic("not implemented")
+	if dc < 1 {
+		return nil, RequestError(
+			"custom.extension.dc.required",
+			"custom: extension domain required but missing",
+		)
+	}
+	pkg := strings.Trim(normalizeName(spec.GetPath()), "/")
+	pkg = strings.TrimPrefix(pkg, ExtensionsDir+"/")
+	if pkg == "" {
+		return nil, RequestError(
+			"custom.extension.path.required",
+			"custom: extension( path: ); base dictionary type required but missing",
+		)
+	}
+	base, err := extensionFor(context.TODO(), pkg)
+	if err != nil {
+		return nil, RequestError(
+			"custom.extension.path.invalid",
+			"custom: extension( path: %s ); %v", pkg, err,
+		)
+	}
+	desc := &custompb.Dataset{
+		Repo:    base.Name(),
+		Path:    path.Join(ExtensionsDir, base.Name()),
+		Fields:  make([]*custompb.Field, 0, len(spec.GetFields())),
+		Indices: spec.GetIndices(),
+	}
+	for _, field := range spec.GetFields() {
+		field = proto.Clone(field).(*custompb.Field)
+		field.Id = strings.TrimSpace(field.GetId())
+		desc.Fields = append(desc.Fields, field)
+	}
+	xt := ExtensionOf(dc, desc)
+	if err := joinRequestError(
+		"custom.extension.invalid",
+		("custom: " + xt.Path()), xt.Err(),
+	); err != nil {
+		return xt, err
+	}
+	return xt, nil
 }
 
 var _ customrel.ExtensionDescriptor = (*Extension)(nil)
@@ -171,7 +214,7 @@ func extensionFor(ctx context.Context, pkg string) (base customrel.DictionaryDes
 	if !base.IsExtendable() {
 		// Not Extendable !
 		base = nil
-		err = fmt.Errorf("custom: %s type not extendable", base.Path())
+		err = fmt.Errorf("custom: %s type not extendable", pkg)
 		return // nil, NotExtendable
 	}
 	// resolved !
@@ -0,0 +1,110 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	customreg "github.com/webitel/custom/registry"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestNewExtension(t *testing.T) {
+	// [ GLOBAL ] base dictionary type(s)
+	for _, spec := range []*custompb.Dataset{
+		{
+			Repo: "tests", Path: "tests", Primary: "id", Display: "name", Extendable: true,
+			Fields: []*custompb.Field{
+				{Id: "id", Kind: datapb.Kind_int64},
+				{Id: "name", Kind: datapb.Kind_string},
+			},
+		},
+		{
+			Repo: "samples", Path: "samples", Primary: "id", Display: "id",
+			Fields: []*custompb.Field{
+				{Id: "id", Kind: datapb.Kind_int64},
+			},
+		},
+	} {
+		base := DictionaryOf(0, spec)
+		if err := customreg.Register(base); err != nil {
+			t.Fatalf("customreg.Register(%s) error = %v", spec.Path, err)
+		}
+		t.Cleanup(func() { _ = customreg.Unregister(base) })
+	}
+
+	tests := []struct {
+		name string
+		dc   int64
+		spec *custompb.InputExtension
+		want string // error id ; if any
+	}{
+		{
+			name: "valid",
+			dc:   1,
+			spec: &custompb.InputExtension{
+				Path: " Extensions/Tests ",
+				Fields: []*custompb.Field{
+					{Id: " score ", Kind: datapb.Kind_int32},
+				},
+			},
+		},
+		{
+			name: "domain",
+			spec: &custompb.InputExtension{Path: "tests"},
+			want: "custom.extension.dc.required",
+		},
+		{
+			name: "path.required",
+			dc:   1,
+			spec: &custompb.InputExtension{Path: " / "},
+			want: "custom.extension.path.required",
+		},
+		{
+			name: "path.not_found",
+			dc:   1,
+			spec: &custompb.InputExtension{Path: "unknown"},
+			want: "custom.extension.path.invalid",
+		},
+		{
+			name: "path.not_extendable",
+			dc:   1,
+			spec: &custompb.InputExtension{Path: "samples"},
+			want: "custom.extension.path.invalid",
+		},
+		{
+			name: "structure",
+			dc:   1,
+			spec: &custompb.InputExtension{
+				Path: "tests",
+				Fields: []*custompb.Field{
+					{Id: "score", Kind: datapb.Kind_int32},
+					{Id: "Score", Kind: datapb.Kind_string},
+				},
+			},
+			want: "custom.extension.invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xt, err := NewExtension(tt.dc, tt.spec)
+			if tt.want != "" {
+				var re *Error
+				if !errors.As(err, &re) || re.Id != tt.want {
+					t.Fatalf("NewExtension() error = %v, want %s", err, tt.want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewExtension() error = %v", err)
+			}
+			if xt.Path() != "extensions/tests" || xt.Dc() != 1 {
+				t.Errorf("NewExtension() path = %s, want extensions/tests", xt.Path())
+			}
+			// [primary] of the base type
+			if xt.Primary().Name() != "id" || xt.Fields().ByName("score") == nil {
+				t.Errorf("NewExtension() fields = %d, want { id, score }", xt.Fields().Num())
+			}
+		})
+	}
+}
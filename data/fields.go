@@ -73,6 +73,14 @@ func (fd *Field) kindOfType() (kind customrel.Kind) {
 		kind = customrel.DATETIME
 	case *custompb.Field_Duration:
 		kind = customrel.DURATION
+	case *custompb.Field_Record:
+		kind = customrel.RECORD
+	case *custompb.Field_Enum:
+		kind = customrel.ENUM
+	case *custompb.Field_Decimal:
+		kind = customrel.DECIMAL
+	case *custompb.Field_Money:
+		kind = customrel.MONEY
 	}
 	return // kind ? customrel.NONE
 }
@@ -118,11 +126,11 @@ func (fd *Field) typeOf(kind customrel.Kind) (rtyp Type) {
 	case customrel.UINT64:
 		rtyp = Uint64.As(spec.GetUint64())
 	case customrel.FLOAT:
-		rtyp = FloatAs(spec.GetFloat())
+		rtyp = Float32.As(spec.GetFloat())
 	case customrel.FLOAT32:
-		rtyp = FloatAs(spec.GetFloat32())
+		rtyp = Float32.As(spec.GetFloat32())
 	case customrel.FLOAT64:
-		rtyp = FloatAs(spec.GetFloat64())
+		rtyp = Float64.As(spec.GetFloat64())
 	case customrel.BINARY:
 		rtyp = BinaryAs(spec.GetBinary())
 	case customrel.LOOKUP:
@@ -130,7 +138,12 @@ func (fd *Field) typeOf(kind customrel.Kind) (rtyp Type) {
 			ds := fd.list.typo
 			rtyp = LookupAs(nil, ds.Dc(), spec.GetLookup(),
 				func(_ context.Context, _ int64, pkg string) (customrel.DictionaryDescriptor, error) {
-					if self, ok := ds.(customrel.DictionaryDescriptor); ok {
+					self, ok := ds.(customrel.DictionaryDescriptor)
+					if raw, is := ds.(*dataset); is && strings.HasPrefix(raw.Path(), DictionariesDir+"/") {
+						// [NOTE] the dictionary under construction ; self-reference
+						self, ok = Dictionary{raw}, true
+					}
+					if ok {
 						eq := strings.EqualFold
 						for _, dn := range []string{
 							self.Path(), // self.Name(),
@@ -152,6 +165,14 @@ func (fd *Field) typeOf(kind customrel.Kind) (rtyp Type) {
 		rtyp = DateTimeAs(spec.GetDatetime())
 	case customrel.DURATION:
 		rtyp = DurationAs(spec.GetDuration())
+	case customrel.RECORD:
+		rtyp = StructAs(fd.list.typo, fd.Name(), spec.GetRecord())
+	case customrel.ENUM:
+		rtyp = EnumAs(spec.GetEnum())
+	case customrel.DECIMAL:
+		rtyp = DecimalAs(spec.GetDecimal())
+	case customrel.MONEY:
+		rtyp = MoneyAs(spec.GetMoney())
 	case customrel.NONE:
 		// neither [kind] nor [type] spec
 		rtyp = UndefinedAs(ErrNoType)
@@ -187,8 +208,22 @@ func (fd *Field) Usage() string {
 	return fd.spec.GetHint()
 }
 
+// Default value specification of the field, if any.
+// Either [default] -or- [always] value expression,
+// e.g.: "$(timestamp)", "$(user)", or a constant value.
 func (fd *Field) Default() any {
-	panic("not implemented") // TODO: Implement
+	expr, _ := fieldValueOf(fd)
+	if expr == nil {
+		return nil
+	}
+	return expr.AsInterface()
+}
+
+// IsAlways reports whether the field value is
+// (re)evaluated on every write, rather than on insert only.
+func (fd *Field) IsAlways() bool {
+	_, always := fieldValueOf(fd)
+	return always
 }
 
 func (fd *Field) IsPrimary() bool {
@@ -296,7 +331,11 @@ func (fs *Fields) get(i int) *Field {
 				rtyp: nil, // NOT resolved yet !
 			}
 			// [RE]INDEX hash [byName]
-			fs.hash[dn] = fd
+			// [NOTE] the first one declared wins, if duplicate
+			dn = strings.ToLower(fd.Name())
+			if fx := fs.hash[dn]; fx == nil || fx.ver != fs.ver || fx.num > fd.num {
+				fs.hash[dn] = fd
+			}
 		}
 		return fd
 	}
@@ -0,0 +1,185 @@
+package data
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+	"google.golang.org/protobuf/proto"
+)
+
+// IndexDescriptor of the Dataset structure.
+type Index struct {
+	num  int // positive (non-zero) integer, index(+1) within its collection
+	name string
+	typo customrel.DatasetDescriptor
+	spec *custompb.Index
+}
+
+var _ customrel.IndexDescriptor = (*Index)(nil)
+
+// Num of the index within it's collection.
+func (ix *Index) Num() int {
+	return ix.num
+}
+
+// Name of the index, unique within dataset.
+func (ix *Index) Name() string {
+	return ix.name
+}
+
+// IsUnique reports whether [fields] values MUST be unique within dataset.
+func (ix *Index) IsUnique() bool {
+	return ix.spec.GetUnique()
+}
+
+// Fields (names) of the index key, ordered.
+func (ix *Index) Fields() []string {
+	return slices.Clone(ix.spec.GetFields())
+}
+
+// Include (names) of the non-key field(s) to be covered by the index.
+func (ix *Index) Include() []string {
+	return slices.Clone(ix.spec.GetInclude())
+}
+
+// Dataset that this Index belongs to ..
+func (ix *Index) Dataset() customrel.DatasetDescriptor {
+	return ix.typo
+}
+
+func (ix *Index) Descriptor() *custompb.Index {
+	if ix.spec != nil {
+		return proto.Clone(ix.spec).(*custompb.Index)
+	}
+	return nil
+}
+
+// Err checks the index specification integrity
+// against the [Dataset] fields structure.
+func (ix *Index) Err() error {
+	return ix.violations().Err()
+}
+
+// violations of the index specification integrity
+func (ix *Index) violations() (errs Violations) {
+	var (
+		name   = ix.Name()
+		elem   = "indices." + name
+		fields = ix.Dataset().Fields()
+		keys   = make(map[string]bool) // set[lower(field)]
+	)
+	if !fieldNameRegexp.MatchString(name) {
+		errs = append(errs, newViolation(elem, "custom.dataset.index.name.invalid", "indices( name: %s ); invalid syntax", name))
+	}
+	if len(ix.spec.GetFields()) == 0 {
+		errs = append(errs, newViolation(elem+".fields", "custom.dataset.index.fields.required", "indices( name: %s ); fields required but missing", name))
+	}
+	// accept [list] of field names
+	accept := func(list string, names []string) {
+		for i, dn := range names {
+			path := fmt.Sprintf("%s.%s[%d]", elem, list, i)
+			fd := fields.ByName(dn)
+			if fd == nil {
+				errs = append(errs, newViolation(path, "custom.dataset.index.field.not_found", "indices( name: %s ).%s( %s ); no such field", name, list, dn))
+				continue
+			}
+			dn = strings.ToLower(fd.Name())
+			if keys[dn] {
+				errs = append(errs, newViolation(path, "custom.dataset.index.field.duplicate", "indices( name: %s ).%s( %s ); duplicate", name, list, fd.Name()))
+				continue
+			}
+			keys[dn] = true
+		}
+	}
+	accept("fields", ix.spec.GetFields())
+	accept("include", ix.spec.GetInclude())
+	return // errs
+}
+
+// IndexDescriptors collection. Readonly
+type Indices struct {
+	typo customrel.DatasetDescriptor
+	list []Index // ordered by name
+}
+
+var _ customrel.IndexDescriptors = (*Indices)(nil)
+
+func newIndexDescriptors(typo customrel.DatasetDescriptor, data map[string]*custompb.Index) Indices {
+	names := make([]string, 0, len(data))
+	for name := range data {
+		names = append(names, name)
+	}
+	// [NOTE] map order is undefined; keep stable !
+	slices.Sort(names)
+	c := Indices{
+		typo: typo,
+		list: make([]Index, len(names)),
+	}
+	for i, name := range names {
+		c.list[i] = Index{
+			num:  (i + 1),
+			name: name,
+			typo: typo, // [NOTE] bound once ; readonly
+			spec: data[name],
+		}
+	}
+	return c
+}
+
+// Num returns count of the Indices.
+func (c *Indices) Num() int {
+	return len(c.list)
+}
+
+// Get IndexDescriptor by index / position
+func (c *Indices) Get(i int) customrel.IndexDescriptor {
+	if 0 <= i && i < len(c.list) {
+		return &c.list[i]
+	}
+	// Not Found
+	return nil
+}
+
+// Get IndexDescriptor by it's name.
+func (c *Indices) ByName(name string) customrel.IndexDescriptor {
+	for i := range c.list {
+		if strings.EqualFold(name, c.list[i].name) {
+			return c.Get(i)
+		}
+	}
+	// Not Found
+	return nil
+}
+
+// Range iterates over all collection
+func (c *Indices) Range(next func(customrel.IndexDescriptor) bool) {
+	for i := range c.list {
+		if !next(c.Get(i)) {
+			break // return
+		}
+	}
+}
+
+// Err checks all the collection specifications integrity.
+func (c *Indices) Err() error {
+	return c.violations().Err()
+}
+
+// violations of the collection specifications integrity
+func (c *Indices) violations() (errs Violations) {
+	dup := make(map[string]bool, len(c.list))
+	for i := range c.list {
+		ix := c.Get(i).(*Index)
+		dn := strings.ToLower(ix.Name())
+		if dup[dn] {
+			errs = append(errs, newViolation("indices."+ix.Name(), "custom.dataset.index.name.duplicate", "indices( name: %s ); duplicate", ix.Name()))
+			continue
+		}
+		dup[dn] = true
+		errs = append(errs, ix.violations()...)
+	}
+	return // errs
+}
@@ -0,0 +1,46 @@
+package data
+
+import (
+	"sync"
+	"testing"
+
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestIndices(t *testing.T) {
+	cities := DictionaryOf(1, &custompb.Dataset{
+		Repo: "cities", Path: "dictionaries/cities",
+		Primary: "id", Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "code", Kind: datapb.Kind_string},
+		},
+		Indices: map[string]*custompb.Index{
+			"name": {Fields: []string{"name"}},
+			"code": {Unique: true, Fields: []string{"code"}},
+		},
+	})
+	// [NOTE] the shared descriptor is readonly ; run with -race
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cities.Indices().Range(func(ix customrel.IndexDescriptor) bool {
+				if err := ix.(*Index).Err(); err != nil {
+					t.Errorf("Indices(%s).Err() = %v", ix.Name(), err)
+				}
+				return true
+			})
+		}()
+	}
+	wg.Wait()
+
+	ix, _ := cities.Indices().ByName("CODE").(*Index)
+	if ix == nil || ix.Num() != 1 || !ix.IsUnique() || ix.Dataset().Path() != cities.Path() {
+		t.Errorf("Indices.ByName(CODE) = %v, want { num: 1, unique }", ix)
+	}
+}
@@ -0,0 +1,335 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+)
+
+// LookupResolver verifies the existence of the referenced dictionary record(s).
+type LookupResolver interface {
+	// ResolveLookup finds the [typeOf] dictionary record(s) of the [dc] domain by the primary key [ids], in batch,
+	// matching the [filter] field value(s), if any ; see Lookup.QueryOf. The nil value matches the NULL one.
+	// Returns the reference(s) of the record(s) found, with the [name] and [type] filled in.
+	// The same [id] MAY be returned more than once, if ambiguous.
+	ResolveLookup(ctx context.Context, dc int64, typeOf customrel.DictionaryDescriptor, filter map[string]any, ids ...string) ([]*custompb.Lookup, error)
+}
+
+// lookupResolver default ; see SetLookupResolver.
+var lookupResolver LookupResolver
+
+// SetLookupResolver sets the default [res]olver for all the [Lookup] types
+// with no resolver of their own. Nil disables the existence check(s).
+// [NOTE] SHOULD be set once, on the service startup.
+func SetLookupResolver(res LookupResolver) {
+	lookupResolver = res
+}
+
+// LookupRecords is an in-memory [LookupResolver] stand-in.
+// map[path][]record ; the record field(s) value(s) by name, regardless of the domain.
+type LookupRecords map[string][]map[string]any
+
+var _ LookupResolver = LookupRecords(nil)
+
+// ResolveLookup finds the [typeOf] dictionary record(s) by the primary key [ids], matching the [filter].
+func (c LookupRecords) ResolveLookup(_ context.Context, _ int64, typeOf customrel.DictionaryDescriptor, filter map[string]any, ids ...string) ([]*custompb.Lookup, error) {
+	var (
+		path    = typeOf.Path()
+		primary = typeOf.Primary().Name()
+		display = typeOf.Display().Name()
+		find    = make(map[string]bool, len(ids))
+		list    []*custompb.Lookup
+		text    = func(v any) string {
+			if v == nil {
+				return ""
+			}
+			return fmt.Sprint(v)
+		}
+	)
+	for _, id := range ids {
+		find[id] = true
+	}
+next:
+	for _, rec := range c[path] {
+		id := text(rec[primary])
+		if !find[id] {
+			continue
+		}
+		for name, want := range filter {
+			if (want == nil) != (rec[name] == nil) || text(want) != text(rec[name]) {
+				continue next
+			}
+		}
+		list = append(list, &custompb.Lookup{
+			Id: id, Name: text(rec[display]), Type: path,
+		})
+	}
+	return list, nil
+}
+
+// WithResolver returns a copy of the [dt] reference type
+// verifying the existence of the value(s) using the [res]olver given.
+func (dt *Lookup) WithResolver(res LookupResolver) *Lookup {
+	ref := *dt
+	ref.res = res
+	return &ref
+}
+
+// Resolver of the referenced record(s) existence, if any ; see SetLookupResolver.
+func (dt *Lookup) Resolver() LookupResolver {
+	if dt != nil && dt.res != nil {
+		return dt.res
+	}
+	return lookupResolver
+}
+
+// Resolve verifies the existence of the [refs] record(s) of the [dc] domain, in batch,
+// and fills in their [name] and [type] from the referenced dictionary.
+// The [rec]ord field value(s) are used to evaluate the Query filter(s), if any.
+// Returns the first "not_found" or "too_much_records" violation error, if any.
+// No-op if there is no resolver available.
+func (dt *Lookup) Resolve(ctx context.Context, dc int64, rec *Record, refs ...*custompb.Lookup) error {
+	batch := lookupBatch{typeOf: dt, res: dt.Resolver(), filter: dt.QueryOf(rec)}
+	for _, ref := range refs {
+		batch.add("", ref)
+	}
+	errs, err := batch.resolve(ctx, dc)
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs[0].cause
+	}
+	return nil
+}
+
+// ResolveLookups verifies the existence of all the [LOOKUP] field value(s) of the record,
+// including the list element(s) and the nested record(s), in batch per referenced dictionary.
+// The reference(s) found get their [name] and [type] filled in.
+// The [res]olver given is used for the lookup type(s) with no resolver available, if any.
+//
+// Returns [Violations] of all the reference(s) not found or ambiguous.
+func (e *Record) ResolveLookups(ctx context.Context, res LookupResolver) error {
+	var (
+		order []string
+		group = make(map[string]*lookupBatch)
+	)
+	e.rangeLookups("", func(rec *Record, path string, dt *Lookup, ref *custompb.Lookup) {
+		rel := dt.Dictionary()
+		if rel == nil {
+			return // type error ; see Lookup.Err()
+		}
+		filter := dt.QueryOf(rec)
+		key := strconv.FormatInt(rel.Dc(), 10) + ":" + rel.Path() + lookupFilterKey(filter)
+		batch := group[key]
+		if batch == nil {
+			batch = &lookupBatch{typeOf: dt, res: dt.Resolver(), filter: filter}
+			if batch.res == nil {
+				batch.res = res
+			}
+			group[key] = batch
+			order = append(order, key)
+		}
+		batch.add(path, ref)
+	})
+	var errs Violations
+	for _, key := range order {
+		list, err := group[key].resolve(ctx, e.typeof.Dc())
+		if err != nil {
+			return err
+		}
+		errs = append(errs, list...)
+	}
+	return errs.Err()
+}
+
+// ResolveLookupNames fills in the [name] and [type] of all the [LOOKUP] field value(s) of the [list] record(s),
+// including the list element(s) and the nested record(s), in batch per referenced dictionary,
+// regardless of the lookup Query filter(s). The reference(s) not found are kept as is.
+// The [res]olver given is used for the lookup type(s) with no resolver available, if any.
+func ResolveLookupNames(ctx context.Context, res LookupResolver, list ...*Record) error {
+	type batch struct {
+		dc     int64
+		typeOf customrel.DictionaryDescriptor
+		res    LookupResolver
+		ids    []string
+		refs   map[string][]*custompb.Lookup // map[id]
+	}
+	var (
+		order []string
+		group = make(map[string]*batch)
+	)
+	for _, e := range list {
+		if e == nil {
+			continue
+		}
+		dc := e.typeof.Dc()
+		e.rangeLookups("", func(_ *Record, _ string, dt *Lookup, ref *custompb.Lookup) {
+			rel := dt.Dictionary()
+			if rel == nil {
+				return // type error ; see Lookup.Err()
+			}
+			key := strconv.FormatInt(dc, 10) + ":" + strconv.FormatInt(rel.Dc(), 10) + ":" + rel.Path()
+			c := group[key]
+			if c == nil {
+				c = &batch{dc: dc, typeOf: rel, res: dt.Resolver(), refs: make(map[string][]*custompb.Lookup)}
+				if c.res == nil {
+					c.res = res
+				}
+				group[key] = c
+				order = append(order, key)
+			}
+			id := ref.GetId()
+			if _, ok := c.refs[id]; !ok {
+				c.ids = append(c.ids, id)
+			}
+			c.refs[id] = append(c.refs[id], ref)
+		})
+	}
+	for _, key := range order {
+		c := group[key]
+		if c.res == nil {
+			continue // no resolver
+		}
+		found, err := c.res.ResolveLookup(ctx, c.dc, c.typeOf, nil, c.ids...)
+		if err != nil {
+			return err
+		}
+		for _, match := range found {
+			refs := c.refs[match.GetId()]
+			delete(c.refs, match.GetId()) // [NOTE] ambiguous ; first one wins
+			for _, ref := range refs {
+				ref.Name = match.GetName()
+				ref.Type = match.GetType()
+				if ref.Type == "" {
+					ref.Type = c.typeOf.Path()
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// rangeLookups calls [next] for each non-NULL [LOOKUP] value of the record,
+// including the list element(s) and the nested record(s) ones.
+// The [rec] is the (nested) record the value belongs to.
+func (e *Record) rangeLookups(prefix string, next func(rec *Record, path string, dt *Lookup, ref *custompb.Lookup)) {
+	e.mu.Lock()
+	values := make([]any, len(e.values))
+	copy(values, e.values)
+	e.mu.Unlock()
+
+	e.typeof.Fields().Range(func(fd customrel.FieldDescriptor) bool {
+		path := prefix + fd.Name()
+		switch vs := values[fd.Num()-1].(type) {
+		case *custompb.Lookup:
+			if dt, ok := fd.Type().(*Lookup); ok && vs.GetId() != "" {
+				next(e, path, dt, vs)
+			}
+		case []*custompb.Lookup:
+			list, _ := fd.Type().(*List)
+			if dt, ok := list.Elem().(*Lookup); ok {
+				for i, ref := range vs {
+					if ref.GetId() != "" {
+						next(e, path+"["+strconv.Itoa(i)+"]", dt, ref)
+					}
+				}
+			}
+		case *Record:
+			if vs != nil {
+				vs.rangeLookups(path+".", next)
+			}
+		}
+		return true // next
+	})
+}
+
+// lookupBatch of the same dictionary reference(s) to be resolved.
+type lookupBatch struct {
+	typeOf *Lookup
+	res    LookupResolver
+	filter map[string]any // evaluated ; see Lookup.QueryOf
+	path   []string       // field path of the reference(s), if any
+	refs   []*custompb.Lookup
+}
+
+func (c *lookupBatch) add(path string, ref *custompb.Lookup) {
+	if ref.GetId() == "" {
+		return // NULL
+	}
+	c.path = append(c.path, path)
+	c.refs = append(c.refs, ref)
+}
+
+// resolve the batch reference(s) of the [dc] domain.
+// Returns [Violations] of the reference(s) not found or ambiguous.
+func (c *lookupBatch) resolve(ctx context.Context, dc int64) (errs Violations, err error) {
+	rel := c.typeOf.Dictionary()
+	if c.res == nil || rel == nil || len(c.refs) == 0 {
+		return nil, nil // nothing to check
+	}
+	var (
+		ids  = make([]string, 0, len(c.refs))
+		uniq = make(map[string]bool, len(c.refs))
+	)
+	for _, ref := range c.refs {
+		if id := ref.GetId(); !uniq[id] {
+			uniq[id] = true
+			ids = append(ids, id)
+		}
+	}
+	list, err := c.res.ResolveLookup(ctx, dc, rel, c.filter, ids...)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string][]*custompb.Lookup, len(list))
+	for _, ref := range list {
+		found[ref.GetId()] = append(found[ref.GetId()], ref)
+	}
+	for i, ref := range c.refs {
+		var rule string
+		switch match := found[ref.GetId()]; len(match) {
+		case 1:
+			ref.Name = match[0].GetName()
+			ref.Type = match[0].GetType()
+			if ref.Type == "" {
+				ref.Type = rel.Path()
+			}
+			continue // [OK]
+		case 0:
+			rule = "not_found"
+		default:
+			rule = "too_much_records"
+		}
+		re := c.typeOf.ViolationError(rule, ref.GetId()).(*Error)
+		errs = append(errs, &Violation{
+			Field: c.path[i], Rule: re.Id, Message: re.Message, cause: re,
+		})
+	}
+	return errs, nil
+}
+
+// lookupFilterKey returns the [filter] canonical form, to group the batch(es) by.
+func lookupFilterKey(filter map[string]any) string {
+	if len(filter) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(filter))
+	for name := range filter {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var key strings.Builder
+	for _, name := range names {
+		key.WriteString("&" + strconv.Quote(name) + "=")
+		if v := filter[name]; v != nil {
+			key.WriteString(strconv.Quote(fmt.Sprint(v)))
+		}
+	}
+	return key.String()
+}
@@ -0,0 +1,214 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestRecordResolveLookups(t *testing.T) {
+	teams, err := NewDictionary(1, &custompb.InputDictionary{
+		Name: "teams",
+		Fields: []*custompb.Field{
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "lead", Kind: datapb.Kind_lookup, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "users", Violation: map[string]string{
+					"not_found": "{field}: no such user {value}",
+				}},
+			}},
+			{Id: "members", Kind: datapb.Kind_list, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "users"},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewDictionary() error = %v", err)
+	}
+	users := LookupRecords{
+		"users": {
+			{"id": 1, "name": "admin"},
+			{"id": 2, "name": "operator"},
+			{"id": 3, "name": "twin"},
+			{"id": 3, "name": "twin"},
+		},
+	}
+	var (
+		fields = teams.Fields()
+		rec    = NewRecord(teams)
+	)
+	_ = rec.Set(fields.ByName("name"), "support")
+	_ = rec.Set(fields.ByName("lead"), "1")
+	_ = rec.Set(fields.ByName("members"), []any{"2", "3", "9"})
+
+	// [NOTE] no resolver ; no check
+	if err = rec.ResolveLookups(context.Background(), nil); err != nil {
+		t.Fatalf("ResolveLookups(nil) error = %v", err)
+	}
+
+	var errs Violations
+	err = rec.ResolveLookups(context.Background(), users)
+	if !errors.As(err, &errs) {
+		t.Fatalf("ResolveLookups() error = %v, want Violations", err)
+	}
+	want := map[string]string{
+		"members[1]": "custom.type.lookup.too_much_records.violation",
+		"members[2]": "custom.type.lookup.not_found.violation",
+	}
+	if len(errs) != len(want) {
+		t.Errorf("ResolveLookups() = %v, want %d violation(s)", errs, len(want))
+	}
+	for _, v := range errs {
+		if want[v.Field] != v.Rule {
+			t.Errorf("ResolveLookups() %s: %s, want %s", v.Field, v.Rule, want[v.Field])
+		}
+	}
+	lead, _ := rec.Get(fields.ByName("lead")).(*custompb.Lookup)
+	if lead.GetName() != "admin" || lead.GetType() != "users" {
+		t.Errorf("ResolveLookups() lead = %v, want {1 admin users}", lead)
+	}
+	members, _ := rec.Get(fields.ByName("members")).([]*custompb.Lookup)
+	if len(members) != 3 || members[0].GetName() != "operator" {
+		t.Errorf("ResolveLookups() members = %v", members)
+	}
+
+	ref := fields.ByName("lead").Type().(*Lookup).WithResolver(users)
+	err = ref.Resolve(context.Background(), 1, nil, &custompb.Lookup{Id: "9"})
+	var re *Error
+	if !errors.As(err, &re) || re.Id != "custom.type.lookup.not_found.violation" {
+		t.Fatalf("Lookup.Resolve() error = %v, want not_found", err)
+	}
+	if want := `custom: lead: no such user "9"`; re.withField("lead").Message != want {
+		t.Errorf("Lookup.Resolve() message = %q, want %q", re.withField("lead").Message, want)
+	}
+}
+
+func TestLookupQuery(t *testing.T) {
+	employees, err := NewDictionary(1, &custompb.InputDictionary{
+		Name: "employees",
+		Fields: []*custompb.Field{
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "team", Kind: datapb.Kind_string},
+			{Id: "manager", Kind: datapb.Kind_lookup, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "dictionaries/employees", Query: map[string]string{
+					"team": "{team}",
+				}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewDictionary() error = %v", err)
+	}
+	staff := LookupRecords{
+		"dictionaries/employees": {
+			{"id": 1, "name": "Alice", "team": "red"},
+			{"id": 2, "name": "Bob", "team": "blue"},
+			{"id": 3, "name": "Carol"},
+		},
+	}
+	var (
+		fields  = employees.Fields()
+		manager = fields.ByName("manager").Type().(*Lookup)
+	)
+	tests := []struct {
+		team    any
+		manager string
+		valid   bool
+	}{
+		{"red", "1", true},
+		{"red", "2", false},
+		{"blue", "2", true},
+		{nil, "3", true},
+		{nil, "1", false},
+	}
+	for _, tt := range tests {
+		rec := NewRecord(employees)
+		_ = rec.Set(fields.ByName("team"), tt.team)
+		_ = rec.Set(fields.ByName("manager"), tt.manager)
+		err := rec.ResolveLookups(context.Background(), staff)
+		if (err == nil) != tt.valid {
+			t.Errorf("team( %v ).manager( %s ) error = %v, valid %v", tt.team, tt.manager, err, tt.valid)
+		}
+		if want := map[string]any{"team": tt.team}; fmt.Sprint(manager.QueryOf(rec)) != fmt.Sprint(want) {
+			t.Errorf("QueryOf() = %v, want %v", manager.QueryOf(rec), want)
+		}
+	}
+
+	invalid, _ := NewDictionary(1, &custompb.InputDictionary{
+		Name: "employees",
+		Fields: []*custompb.Field{
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "manager", Kind: datapb.Kind_lookup, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "dictionaries/employees", Query: map[string]string{
+					"name": "{team}",
+				}},
+			}},
+		},
+	})
+	var errs Violations
+	if err = invalid.Err(); !errors.As(err, &errs) || len(errs) != 1 || errs[0].Rule != "custom.dataset.field.lookup.query.invalid" {
+		t.Errorf("NewDictionary() error = %v, want custom.dataset.field.lookup.query.invalid", err)
+	}
+}
+
+func TestResolveLookupNames(t *testing.T) {
+	tasks, err := NewDictionary(1, &custompb.InputDictionary{
+		Name: "tasks",
+		Fields: []*custompb.Field{
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "owner", Kind: datapb.Kind_lookup, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "users"},
+			}},
+			{Id: "watchers", Kind: datapb.Kind_list, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "users"},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewDictionary() error = %v", err)
+	}
+	var (
+		calls int
+		users = LookupRecords{
+			"users": {
+				{"id": 1, "name": "admin"},
+				{"id": 2, "name": "operator"},
+			},
+		}
+		fields = tasks.Fields()
+		list   = []*Record{NewRecord(tasks), NewRecord(tasks)}
+	)
+	_ = list[0].Set(fields.ByName("owner"), "1")
+	_ = list[0].Set(fields.ByName("watchers"), []any{"2", "9"})
+	_ = list[1].Set(fields.ByName("owner"), "2")
+
+	res := lookupResolverFunc(func(ctx context.Context, dc int64, typeOf customrel.DictionaryDescriptor, filter map[string]any, ids ...string) ([]*custompb.Lookup, error) {
+		calls++
+		return users.ResolveLookup(ctx, dc, typeOf, filter, ids...)
+	})
+	if err = ResolveLookupNames(context.Background(), res, list...); err != nil {
+		t.Fatalf("ResolveLookupNames() error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("ResolveLookupNames() queries = %d, want 1", calls)
+	}
+	owner, _ := list[1].Get(fields.ByName("owner")).(*custompb.Lookup)
+	if owner.GetName() != "operator" || owner.GetType() != "users" {
+		t.Errorf("ResolveLookupNames() owner = %v, want {2 operator users}", owner)
+	}
+	watchers, _ := list[0].Get(fields.ByName("watchers")).([]*custompb.Lookup)
+	if len(watchers) != 2 || watchers[0].GetName() != "operator" || watchers[1].GetName() != "" {
+		t.Errorf("ResolveLookupNames() watchers = %v", watchers)
+	}
+}
+
+// lookupResolverFunc adapter.
+type lookupResolverFunc func(ctx context.Context, dc int64, typeOf customrel.DictionaryDescriptor, filter map[string]any, ids ...string) ([]*custompb.Lookup, error)
+
+func (fn lookupResolverFunc) ResolveLookup(ctx context.Context, dc int64, typeOf customrel.DictionaryDescriptor, filter map[string]any, ids ...string) ([]*custompb.Lookup, error) {
+	return fn(ctx, dc, typeOf, filter, ids...)
+}
@@ -1,8 +1,12 @@
 package data
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -13,8 +17,23 @@ import (
 	custompb "github.com/webitel/proto/gen/custom"
 )
 
+// WriteMode of the Record field(s) value(s).
+type WriteMode uint8
+
+const (
+	// WriteSystem mode of the record writes ; default.
+	// Values are assigned by the system, e.g.: storage, defaults.
+	// Field(s) readonly and disabled constraints are not enforced.
+	WriteSystem WriteMode = iota
+	// WriteUser mode of the record writes.
+	// Values are assigned on behalf of the end-user.
+	// Field(s) readonly and disabled reject the input.
+	WriteUser
+)
+
 type Record struct {
 	mu     sync.Mutex // protects mutations
+	mode   WriteMode  // of the field(s) writes
 	typeof customrel.DatasetDescriptor
 	values []any    // map[string]any
 	fields []string // changed(!)
@@ -79,23 +98,35 @@ func (e *Record) Set(fd customrel.FieldDescriptor, val any) error {
 
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	return e.setAs(fd, val, e.mode)
+}
+
+// setAs sets the field value in the given write [mode],
+// e.g. of the parent record ; see SetPath.
+// [NOTE] Caller MUST hold the e.mu lock.
+func (e *Record) setAs(fd customrel.FieldDescriptor, val any, mode WriteMode) error {
 	// [CHECK] known data field
 	off := fd.Num() - 1 // offset
 	if e.typeof.Fields().Get(off) != fd {
 		return fmt.Errorf("custom: record.set( field: %s ); invalid descriptor", fd.Name())
 	}
-	// [TODO]: distinguish two different modes, like:
-	// - [enduser].Set(value)
-	// - [default].Set(value)
-	// [DESIGN]: e.BeginUpdate(); e.EndUpdate(); methods ???
-	// // CONSTRAINT: DISABLED
-	// if fd.IsDisabled() { // is [user] set ? or [default] value ?
-	// 	return RequestError(
-	// 		"custom.field.disabled.violation",
-	// 		"custom: field( %s ) is disabled",
-	// 		fd.Name(),
-	// 	)
-	// }
+	// CONSTRAINT: [enduser] input
+	if mode == WriteUser {
+		if fd.IsReadonly() {
+			return RequestError(
+				"custom.field.readonly.violation",
+				"custom: field( %s ) is readonly",
+				fd.Name(),
+			)
+		}
+		if fd.IsDisabled() {
+			return RequestError(
+				"custom.field.disabled.violation",
+				"custom: field( %s ) is disabled",
+				fd.Name(),
+			)
+		}
+	}
 	// [CHECK] data type constrains
 	rv := fd.Type().New()
 	// CONSTRAINT TYPE
@@ -108,36 +139,159 @@ func (e *Record) Set(fd customrel.FieldDescriptor, val any) error {
 	err = rv.Err()
 	if err != nil {
 		// Field Value Type constraints violation
+		if re, is := err.(*Error); is {
+			return re.withField(fd.Name())
+		}
 		return err
 	}
 	// normalized !
 	val = rv.Interface()
-	// // CONSTRAINT: REQUIRED
-	// if fd.IsRequired() {
-	// 	// must := fd.Default().Always()
-	// 	if customrel.IsNull(val) { // [FIXME]: || IsZero(?)
-	// 		return RequestError(
-	// 			"custom.field.required.violation",
-	// 			"custom: field(%s) value required but missing",
-	// 			fd.Name(),
-	// 		)
-	// 	}
-	// }
+	// [NOTE] CONSTRAINT: REQUIRED ; see Validate(!)
 	// UPDATE ; SAVE
 	return e.set(fd, val)
 }
 
-// func (e *Record) BeginUpdate() {
-// 	e.ref.Lock()
-// 	defer e.ref.Unlock()
-// 	e.fields = nil
-// }
+// field returns the [path] field descriptor, resolved within nested [RECORD] structure(s),
+// and the nested record it belongs to. The [create] indicates whether to create
+// missing nested record(s) on the way, written in the given [mode].
+//
+// [NOTE] Field names MAY contain dots, e.g.: "name.common_name",
+// so the exact field name match takes precedence over the nested path.
+func (e *Record) field(path string, create bool, mode WriteMode) (*Record, customrel.FieldDescriptor, error) {
+	fields := e.typeof.Fields()
+	if fd := fields.ByName(path); fd != nil {
+		return e, fd, nil
+	}
+	for i := 0; i < len(path); i++ {
+		if path[i] != '.' {
+			continue
+		}
+		fd := fields.ByName(path[:i])
+		if fd == nil || fd.Kind() != customrel.RECORD {
+			continue
+		}
+		nested, _ := e.Get(fd).(*Record)
+		if create {
+			if nested == nil {
+				nested = NewRecord(fd.Type().(*Struct).Dataset())
+			}
+			// [NOTE] mark as changed ; nested field(s) to be set
+			e.mu.Lock()
+			err := e.setAs(fd, nested, mode)
+			e.mu.Unlock()
+			if err != nil {
+				return nil, nil, err
+			}
+		} else if nested == nil {
+			return nil, nil, nil // NULL
+		}
+		return nested.field(path[i+1:], create, mode)
+	}
+	return nil, nil, fmt.Errorf(
+		"custom: record( %s ).field( %s ); no such field",
+		e.typeof.Name(), path,
+	)
+}
 
-// func (e *Record) EndUpdate() {
-// 	// e.ref.Lock()
-// 	// defer e.ref.Unlock()
-// 	// e.fields = nil
-// }
+// GetPath returns the field value by it's [path] name,
+// e.g.: "address.city" of the nested [RECORD] structure.
+func (e *Record) GetPath(path string) (any, error) {
+	rec, fd, err := e.field(path, false, WriteSystem)
+	if err != nil || fd == nil {
+		return nil, err
+	}
+	return rec.Get(fd), nil
+}
+
+// SetPath sets the field value by it's [path] name,
+// e.g.: "address.city" of the nested [RECORD] structure.
+// Missing nested record(s) on the way are created.
+// The nested field(s) are written in the mode of this record ; see BeginUpdate.
+func (e *Record) SetPath(path string, val any) error {
+	mode := e.Mode()
+	rec, fd, err := e.field(path, true, mode)
+	if err != nil {
+		return err
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return rec.setAs(fd, val, mode)
+}
+
+// BeginUpdate switches the record writes into given [mode]
+// until EndUpdate is called.
+func (e *Record) BeginUpdate(mode WriteMode) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.mode = mode
+}
+
+// EndUpdate commits the record writes, switching back to the WriteSystem mode.
+// Returns [Violations] of the record field(s) constraints, if any ; see Validate.
+func (e *Record) EndUpdate(partial bool) error {
+	e.mu.Lock()
+	e.mode = WriteSystem
+	e.mu.Unlock()
+	return e.Validate(partial)
+}
+
+// Mode of the record writes.
+func (e *Record) Mode() WriteMode {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.mode
+}
+
+// Validate the record value(s) against it's dataset field(s) constraints.
+//
+// The [partial] record, e.g. an update, requires the populated field(s) ONLY to be valid.
+// Otherwise all the required field(s) are to be specified, except for the [primary] key,
+// which MAY be generated by the storage.
+//
+// Returns [Violations] of all the field(s) constraints violated.
+func (e *Record) Validate(partial bool) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var (
+		errs Violations
+		set  = make(map[string]bool, len(e.fields))
+	)
+	for _, name := range e.fields {
+		set[name] = true
+	}
+	e.typeof.Fields().Range(func(fd customrel.FieldDescriptor) bool {
+		name := fd.Name()
+		if nested, _ := e.values[fd.Num()-1].(*Record); nested != nil {
+			// [RECORD] nested structure constraints
+			var sub Violations
+			if errors.As(nested.Validate(partial), &sub) {
+				for _, v := range sub {
+					errs = append(errs, &Violation{
+						Field: name + "." + v.Field, Rule: v.Rule, Message: v.Message,
+					})
+				}
+			}
+		}
+		if !fd.IsRequired() {
+			return true // next
+		}
+		if partial && !set[name] {
+			return true // omitted ; unchanged
+		}
+		if !partial && !set[name] && fd.IsPrimary() {
+			return true // [ STORAGE ] generated
+		}
+		if mapValue(e.values[fd.Num()-1]) == nil {
+			errs = append(errs, newViolation(
+				name, "custom.field.required.violation",
+				"value required but missing",
+			))
+		}
+		return true // next
+	})
+	return errs.Err()
+}
 
 // A set of fields whose values ​​have been updated !
 func (e *Record) Fields() []string {
@@ -185,6 +339,14 @@ func mapValue(v any) any {
 		return nil
 	}
 	switch e := v.(type) {
+	case *Record:
+		{
+			if e == nil {
+				return nil // untyped
+			}
+			// [RECORD] nested structure
+			return e.AsMap()
+		}
 	case *custompb.Lookup:
 		{
 			if e == nil {
@@ -223,6 +385,21 @@ func mapValue(v any) any {
 			}
 			return CastDateTimeAsNumber(*e, time.Millisecond)
 		}
+	case []byte:
+		{
+			if e == nil {
+				return nil // untyped
+			}
+			return base64.StdEncoding.EncodeToString(e)
+		}
+	case *big.Rat:
+		{
+			if e == nil {
+				return nil // untyped
+			}
+			// exact ; JSON number MAY lose precision
+			return decimalString(e, -1)
+		}
 	}
 	// reflect Value.(Nullable) !
 	rv := reflect.ValueOf(v)
@@ -262,6 +439,41 @@ func mapValue(v any) any {
 	// return v
 }
 
+// typeMapValue converts [v] value of the [typ] data type
+// into it's [AsMap] output representation.
+func typeMapValue(typ customrel.Type, v any) any {
+	switch dt := typ.(type) {
+	case *List:
+		{
+			rv := reflect.ValueOf(v)
+			if rv.Kind() != reflect.Slice || rv.IsNil() {
+				break // mapValue(v)
+			}
+			size := rv.Len()
+			list := make([]any, size)
+			for i := range size {
+				list[i] = typeMapValue(
+					dt.Elem(), rv.Index(i).Interface(),
+				)
+			}
+			return list
+		}
+	case *DateTime:
+		{
+			if e, _ := v.(*time.Time); e != nil {
+				return dt.MapValue(*e)
+			}
+		}
+	case *Duration:
+		{
+			if e, _ := v.(*time.Duration); e != nil {
+				return dt.MapValue(*e)
+			}
+		}
+	}
+	return mapValue(v)
+}
+
 func (e *Record) AsMap() map[string]any {
 	if e == nil {
 		return nil
@@ -269,7 +481,10 @@ func (e *Record) AsMap() map[string]any {
 	n := len(e.fields)
 	m := make(map[string]any, n)
 	_, cx := e.typeof.(customrel.ExtensionDescriptor)
-	pk := e.typeof.Primary().Name()
+	var pk string
+	if fd := e.typeof.Primary(); fd != nil {
+		pk = fd.Name()
+	} // else { // [RECORD] nested structure }
 	// [NOTE]: Populated ONLY !
 	e.Range(func(fd customrel.FieldDescriptor, v any) bool {
 		if v == nil {
@@ -281,7 +496,7 @@ func (e *Record) AsMap() map[string]any {
 			cx = false  // once
 			return true // skip
 		}
-		v = mapValue(v)
+		v = typeMapValue(fd.Type(), v)
 		m[fd.Name()] = v
 		return true
 	})
@@ -306,7 +521,8 @@ func (e *Record) Proto() *structpb.Struct {
 	return obj
 }
 
-// FromProto decodes given [set] *Struct object into *Record fields
+// FromProto decodes given [set] *Struct object into *Record fields.
+// Returns [Violations] of all the field(s) failed to be set, if any.
 func (e *Record) FromProto(data *structpb.Struct) error {
 	n := len(data.GetFields())
 	if n == 0 {
@@ -314,18 +530,35 @@ func (e *Record) FromProto(data *structpb.Struct) error {
 		return nil
 	}
 	var (
-		err    error
+		errs   Violations
 		typeof = e.typeof
 		fields = typeof.Fields()
-		field  customrel.FieldDescriptor
+		values = data.AsMap()
+		names  = make([]string, 0, n)
 	)
-	for name, value := range data.AsMap() {
-		if field = fields.ByName(name); field == nil {
-			return fmt.Errorf("record(%s).set(%s); no such field", typeof.Name(), name)
+	for name := range values {
+		names = append(names, name)
+	}
+	// [NOTE] map order is undefined; keep stable !
+	slices.Sort(names)
+	for _, name := range names {
+		field := fields.ByName(name)
+		if field == nil {
+			errs = append(errs, newViolation(
+				name, "custom.field.not_found",
+				"record( %s ); no such field", typeof.Name(),
+			))
+			continue
 		}
-		if err = e.Set(field, value); err != nil {
-			return err
+		if err := e.Set(field, values[name]); err != nil {
+			v := newViolation(
+				name, "custom.field.value.invalid", "%v", err,
+			)
+			if re, is := err.(*Error); is && re.Id != "" {
+				v.Rule, v.cause = re.Id, re
+			}
+			errs = append(errs, v)
 		}
 	}
-	return nil
+	return errs.Err()
 }
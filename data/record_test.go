@@ -0,0 +1,74 @@
+package data
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRecordWriteMode(t *testing.T) {
+	cities, err := NewDictionary(1, &custompb.InputDictionary{
+		Name: "cities",
+		Fields: []*custompb.Field{
+			{Id: "name", Kind: datapb.Kind_string, Required: true},
+			{Id: "code", Kind: datapb.Kind_string, Required: true},
+			{Id: "note", Kind: datapb.Kind_string, Disabled: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewDictionary() error = %v", err)
+	}
+
+	rules := func(err error) []string {
+		var errs Violations
+		if err != nil && !errors.As(err, &errs) {
+			t.Fatalf("error = %T, want Violations", err)
+		}
+		list := make([]string, len(errs))
+		for i, v := range errs {
+			list[i] = v.Field + ":" + v.Rule
+		}
+		return list
+	}
+
+	rec := NewRecord(cities)
+	rec.BeginUpdate(WriteUser)
+	input, _ := structpb.NewStruct(map[string]any{
+		"name":       "Kyiv",
+		"note":       "hidden",
+		"created_at": 1714557600000,
+		"unknown":    true,
+	})
+	got := rules(rec.FromProto(input))
+	want := []string{
+		"created_at:custom.field.readonly.violation",
+		"note:custom.field.disabled.violation",
+		"unknown:custom.field.not_found",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("FromProto() = %q, want %q", got, want)
+	}
+
+	// [required] at commit
+	got = rules(rec.EndUpdate(false))
+	want = []string{
+		"code:custom.field.required.violation",
+		"created_at:custom.field.required.violation",
+		"created_by:custom.field.required.violation",
+		"updated_at:custom.field.required.violation",
+		"updated_by:custom.field.required.violation",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("EndUpdate() = %q, want %q", got, want)
+	}
+	if got = rules(rec.Validate(true)); len(got) > 0 {
+		t.Errorf("Validate(partial) = %q, want none", got)
+	}
+	if rec.Mode() != WriteSystem {
+		t.Errorf("Mode() = %v, want WriteSystem", rec.Mode())
+	}
+}
@@ -30,6 +30,7 @@ func Elem(of Type) Type {
 // int64
 // float64
 // string
+// []byte
 // time.Time
 // Duration
 // []any
@@ -60,6 +61,15 @@ func Indirect(t Type, v any) (any, error) {
 			v = rv.Interface() // NULL(-able) !
 			// process as indirect below !
 		}
+	case []byte:
+		{
+			if e == nil {
+				// NULL
+				return nil, nil
+			}
+			// [binary] !
+			return e, nil
+		}
 	}
 	// reflect
 	rv := reflect.ValueOf(v)
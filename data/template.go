@@ -0,0 +1,226 @@
+package data
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// violationArgs of the message template placeholder(s).
+// map[placeholder]value, e.g.: { "value": "42", "min": "1", "max": "10", "field": "age" }
+type violationArgs map[string]string
+
+// violationTemplate of the primitive type constraint violation error.
+type violationTemplate struct {
+	own  string // spec.violation[rule] ; the locale catalog(s) are NOT applied
+	def  string // default template of the rule
+	args violationArgs
+}
+
+// violationCatalogs of the localized message templates.
+// map[locale]map[id]template
+var violationCatalogs = struct {
+	sync.RWMutex
+	locale map[string]map[string]string
+}{
+	locale: make(map[string]map[string]string),
+}
+
+// RegisterViolations registers the message [templates] catalog for the [locale], e.g.: "uk", "en-US".
+// map[id]template, where [id] is the stable violation error id, e.g.: "custom.type.int.min.violation".
+// The template MAY refer to the {value}, {min}, {max}, {field} and the other rule specific placeholder(s).
+// Subsequent registration(s) for the same [locale] override the given [templates] only.
+func RegisterViolations(locale string, templates map[string]string) {
+	if locale == "" || len(templates) == 0 {
+		return
+	}
+	violationCatalogs.Lock()
+	defer violationCatalogs.Unlock()
+	catalog := violationCatalogs.locale[locale]
+	if catalog == nil {
+		catalog = make(map[string]string, len(templates))
+		violationCatalogs.locale[locale] = catalog
+	}
+	for id, tmpl := range templates {
+		catalog[id] = tmpl
+	}
+}
+
+// localeViolation returns the registered template of the violation [id] for the [locale].
+// Falls back to the language base, e.g.: "uk-UA" => "uk". Returns empty string if none.
+func localeViolation(locale, id string) string {
+	violationCatalogs.RLock()
+	defer violationCatalogs.RUnlock()
+	for locale != "" {
+		if tmpl := violationCatalogs.locale[locale][id]; tmpl != "" {
+			return tmpl
+		}
+		i := strings.LastIndexAny(locale, "-_")
+		if i < 0 {
+			break
+		}
+		locale = locale[0:i]
+	}
+	return ""
+}
+
+// violationError of the primitive [typ] constraint [rule] violated.
+// The field [spec] violation template takes precedence over the [defaults] one.
+//
+// Returns [*Error] with the stable id, e.g.: "custom.type.<typ>.<rule>.violation".
+func violationError(typ, rule string, spec, defaults map[string]string, args violationArgs) *Error {
+	tmpl := &violationTemplate{
+		own:  spec[rule],
+		def:  defaults[rule],
+		args: args,
+	}
+	err := RequestError(
+		"custom.type."+typ+"."+rule+".violation",
+		"custom: %s", tmpl.render("", ""),
+	)
+	err.tmpl = tmpl
+	return err
+}
+
+// render the message text of the violation [id] for the [locale], if registered.
+func (t *violationTemplate) render(locale, id string) string {
+	text := t.own
+	if text == "" && locale != "" {
+		text = localeViolation(locale, id)
+	}
+	if text == "" {
+		text = t.def
+	}
+	return renderViolation(text, t.args)
+}
+
+// withField returns a copy of the [e] violation error
+// with the {field} placeholder bound to the [name].
+// Errors of the other kind are returned as is.
+func (e *Error) withField(name string) *Error {
+	if e == nil || e.tmpl == nil {
+		return e
+	}
+	args := make(violationArgs, len(e.tmpl.args)+1)
+	for param, value := range e.tmpl.args {
+		args[param] = value
+	}
+	args["field"] = name
+	tmpl := *e.tmpl
+	tmpl.args = args
+	err := *e
+	err.tmpl = &tmpl
+	err.Message = "custom: " + tmpl.render("", "")
+	return &err
+}
+
+// Localize returns the [err] violation message(s) rendered for the given [locale],
+// according to the registered catalog(s) ; see RegisterViolations.
+// Field specific violation templates, as well as errors of the other kind, are kept as is.
+func Localize(err error, locale string) error {
+	if err == nil || locale == "" {
+		return err
+	}
+	var list Violations
+	if errors.As(err, &list) {
+		local := make(Violations, len(list))
+		for i, v := range list {
+			local[i] = v
+			if v.cause == nil || v.cause.tmpl == nil {
+				continue
+			}
+			cause := localize(v.cause, locale)
+			local[i] = &Violation{
+				Field:   v.Field,
+				Rule:    v.Rule,
+				Message: cause.Message,
+				cause:   cause,
+			}
+		}
+		return local
+	}
+	var re *Error
+	if errors.As(err, &re) && re.tmpl != nil {
+		return localize(re, locale)
+	}
+	return err
+}
+
+// localize the [e] violation message for the [locale].
+func localize(e *Error, locale string) *Error {
+	text := e.tmpl.render(locale, e.Id)
+	if e.Message == "custom: "+text {
+		return e // unchanged
+	}
+	err := *e
+	err.Message = "custom: " + text
+	return &err
+}
+
+// renderViolation substitutes the {name} placeholder(s) of the [tmpl] with the [args] values.
+// The legacy {{.name}} notation is also supported. Unknown placeholder(s) are kept as is.
+func renderViolation(tmpl string, args violationArgs) string {
+	if len(args) == 0 || !strings.Contains(tmpl, "{") {
+		return tmpl
+	}
+	var text strings.Builder
+	text.Grow(len(tmpl))
+	RangeTemplate(tmpl, func(part string, param bool) bool {
+		if !param {
+			text.WriteString(part)
+		} else if value, ok := args[part]; ok {
+			text.WriteString(value)
+		} else {
+			text.WriteString("{" + part + "}")
+		}
+		return true
+	})
+	return text.String()
+}
+
+// RangeTemplate splits the [tmpl] into the literal text and the {name} placeholder part(s), in order,
+// calling [next] for each one until it returns false. The [param] indicates the placeholder [part] name.
+// The legacy {{.name}} notation is also supported.
+func RangeTemplate(tmpl string, next func(part string, param bool) bool) {
+	var text int // offset of the literal text part
+	for i := 0; i < len(tmpl); {
+		j := strings.IndexByte(tmpl[i:], '{')
+		if j < 0 {
+			break
+		}
+		i += j
+		name, n := violationPlaceholder(tmpl[i:])
+		if n == 0 {
+			i++ // literal '{'
+			continue
+		}
+		if text < i && !next(tmpl[text:i], false) {
+			return
+		}
+		if !next(name, true) {
+			return
+		}
+		i += n
+		text = i
+	}
+	if text < len(tmpl) {
+		next(tmpl[text:], false)
+	}
+}
+
+// violationPlaceholder parses the leading {name} or {{.name}} placeholder of the [s] template.
+// Returns the placeholder [name] and it's [n] length, or zero if none.
+func violationPlaceholder(s string) (name string, n int) {
+	if strings.HasPrefix(s, "{{.") {
+		end := strings.Index(s, "}}")
+		if end < 4 {
+			return "", 0
+		}
+		return s[3:end], end + 2
+	}
+	end := strings.IndexByte(s, '}')
+	if end < 2 || strings.ContainsAny(s[1:end], "{ ") {
+		return "", 0
+	}
+	return s[1:end], end + 1
+}
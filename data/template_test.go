@@ -0,0 +1,72 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestRenderViolation(t *testing.T) {
+	args := violationArgs{"value": "5", "min": "10", "field": "age"}
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{"{field} of {value} is less than {min}", "age of 5 is less than 10"},
+		{"{{.value}} < {{.min}}", "5 < 10"},
+		{"{value} {unknown} {max} {} { value }", "5 {unknown} {max} {} { value }"},
+		{"json {\"a\":{value}}", "json {\"a\":5}"},
+	}
+	for _, tt := range tests {
+		if got := renderViolation(tt.tmpl, args); got != tt.want {
+			t.Errorf("renderViolation(%q) = %q, want %q", tt.tmpl, got, tt.want)
+		}
+	}
+}
+
+func TestViolationError(t *testing.T) {
+	age := SignedAs(32, &datapb.Int{
+		Min: wrapperspb.Int64(18),
+		Max: wrapperspb.Int64(150),
+		Violation: map[string]string{
+			"min": "{field} MUST be at least {min} years ; got: {value}",
+		},
+	})
+	size := UnsignedAs(32, &datapb.Uint{Max: wrapperspb.UInt64(10)})
+
+	RegisterViolations("uk", map[string]string{
+		"custom.type.uint.max.violation": "значення {value} перевищує {max}",
+		"custom.type.int.min.violation":  "значення {value} менше за {min}",
+	})
+
+	var re *Error
+	err := age.New().Decode(int64(16))
+	if !errors.As(err, &re) || re.Id != "custom.type.int.min.violation" {
+		t.Fatalf("Int.Decode(16) error = %v, want custom.type.int.min.violation", err)
+	}
+	if got := re.withField("age").Message; got != "custom: age MUST be at least 18 years ; got: 16" {
+		t.Errorf("Int.Decode(16) message = %q", got)
+	}
+	// [NOTE] the field spec template takes precedence
+	if got := Localize(re, "uk").Error(); got != re.Message {
+		t.Errorf("Localize(uk) = %q, want %q", got, re.Message)
+	}
+
+	err = size.New().Decode(uint64(11))
+	if !errors.As(err, &re) || re.Id != "custom.type.uint.max.violation" {
+		t.Fatalf("Uint.Decode(11) error = %v, want custom.type.uint.max.violation", err)
+	}
+	if got := re.Message; got != "custom: uint 11 violates max boundary of: 10" {
+		t.Errorf("Uint.Decode(11) message = %q", got)
+	}
+	for locale, want := range map[string]string{
+		"uk-UA": "custom: значення 11 перевищує 10",
+		"en":    "custom: uint 11 violates max boundary of: 10",
+	} {
+		if got := Localize(re, locale).Error(); got != want {
+			t.Errorf("Localize(%s) = %q, want %q", locale, got, want)
+		}
+	}
+}
@@ -1,13 +1,236 @@
 package data
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/webitel/custom/internal/pragma"
+	customrel "github.com/webitel/custom/reflect"
 	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// Binary data type
 type Binary struct {
 	spec *datapb.Binary
 }
 
+// BinaryAs primitive type
 func BinaryAs(spec *datapb.Binary) Type {
-	panic("not implemented")
+	return &Binary{spec: spec}
+}
+
+var _ Type = (*Binary)(nil)
+
+// Kind of the data type.
+func (*Binary) Kind() customrel.Kind {
+	return customrel.BINARY
+}
+
+// New data value codec.
+func (dt *Binary) New() customrel.Codec {
+	return &BinaryValue{typof: dt}
+}
+
+// Err to check data type descriptor integrity.
+func (dt *Binary) Err() error {
+	return nil
+}
+
+func (*Binary) Custom(pragma.DoNotImplement) {}
+
+var binaryViolations = map[string]string{
+	"max_bytes": "binary of {value} bytes violates max boundary of: {max_bytes} bytes",
+}
+
+func (dt *Binary) violationError(kind string, val []byte) error {
+	return violationError("binary", kind, dt.spec.GetViolation(), binaryViolations, violationArgs{
+		"value":     strconv.Itoa(len(val)),
+		"max":       strconv.FormatUint(uint64(dt.spec.GetMaxBytes()), 10),
+		"max_bytes": strconv.FormatUint(uint64(dt.spec.GetMaxBytes()), 10),
+	})
+}
+
+// Accept typical value type constraints
+func (dt *Binary) Accept(val []byte) error {
+	if dt == nil || dt.spec == nil {
+		// no constraints
+		return nil // [OK] ; whatever ..
+	}
+	if max := dt.spec.MaxBytes; 0 < max && int(max) < len(val) {
+		return dt.violationError("max_bytes", val)
+	}
+	return nil // [OK]
+}
+
+// BinaryValue represents a byte sequence value
+type BinaryValue struct {
+	typof *Binary
+	value []byte // NULL(-able)
 }
+
+var _ customrel.Codec = (*BinaryValue)(nil)
+
+// Interface of the [[]byte] value.
+func (dv *BinaryValue) Interface() any {
+	if dv != nil {
+		return dv.value
+	}
+	// typical: NULL
+	return ([]byte)(nil)
+}
+
+// Type of the data value
+func (dv *BinaryValue) Type() customrel.Type {
+	if dv != nil {
+		return dv.typof
+	}
+	return (*Binary)(nil)
+}
+
+func (dv *BinaryValue) Err() error {
+	if dv.IsNull() {
+		return nil
+	}
+	return dv.typof.Accept(dv.value)
+}
+
+// implements [Nullable] interface
+func (dv *BinaryValue) IsNull() bool {
+	return dv == nil || dv.value == nil
+}
+
+func (dv *BinaryValue) IsZero() bool {
+	return !dv.IsNull() && len(dv.value) == 0
+}
+
+func (dv *BinaryValue) Decode(src any) error {
+	setValue := func(set []byte) (err error) {
+		err = dv.typof.Accept(set)
+		if err == nil {
+			dv.value = set
+		}
+		return // err
+	}
+	// base64 encoded string value
+	stringValue := func(src *string) error {
+		if src == nil {
+			return setValue(nil) // NULL
+		}
+		input := strings.TrimSpace(*src)
+		if input == "" {
+			return setValue(nil) // NULL
+		}
+		for _, codec := range []*base64.Encoding{
+			base64.StdEncoding, base64.RawStdEncoding,
+			base64.URLEncoding, base64.RawURLEncoding,
+		} {
+			if data, err := codec.DecodeString(input); err == nil {
+				return setValue(data)
+			}
+		}
+		return fmt.Errorf("convert: string %q value into Binary; base64 encoding expected", input)
+	}
+	// accept: src.(type)
+	if src == nil {
+		return setValue(nil)
+	}
+	switch data := src.(type) {
+	case BinaryValue:
+		{
+			return setValue(data.value)
+		}
+	case *BinaryValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			if data == dv {
+				return nil // SELF
+			}
+			return setValue(data.value)
+		}
+	case []byte:
+		{
+			return setValue(data)
+		}
+	case *[]byte:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return setValue(*data)
+		}
+	case string:
+		{
+			return stringValue(&data)
+		}
+	case *string:
+		{
+			return stringValue(data)
+		}
+	case *structpb.Value:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			switch kind := data.Kind.(type) {
+			case nil:
+				{
+					return setValue(nil)
+				}
+			case *structpb.Value_NullValue:
+				{
+					return setValue(nil) // NULL
+				}
+			case *structpb.Value_StringValue:
+				{
+					return stringValue(&kind.StringValue)
+				}
+			// case *structpb.Value_NumberValue:
+			// case *structpb.Value_BoolValue:
+			// case *structpb.Value_ListValue:
+			// case *structpb.Value_StructValue:
+			default:
+				{
+					ref := data.ProtoReflect()
+					def := ref.Descriptor()
+					fd := def.Fields().ByName("kind")
+					return fmt.Errorf(
+						"convert: %s value %v into Binary", strings.TrimSuffix(string(
+							ref.WhichOneof(fd.ContainingOneof()).Name()),
+							"_value",
+						), ref.Get(fd).String(),
+					)
+				}
+			}
+		}
+	case *wrapperspb.BytesValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return setValue(data.Value)
+		}
+	case *wrapperspb.StringValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return stringValue(&data.Value)
+		}
+	}
+	return fmt.Errorf(
+		"convert: %[1]T value %[1]v into Binary",
+		src,
+	)
+}
+
+func (dv *BinaryValue) Encode(dst any) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (*BinaryValue) Custom(pragma.DoNotImplement) {}
@@ -0,0 +1,49 @@
+package data
+
+import (
+	"testing"
+
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestBinaryValueDecode(t *testing.T) {
+	blob := BinaryAs(&datapb.Binary{MaxBytes: 4})
+
+	tests := []struct {
+		name     string
+		input    any
+		expected []byte
+		invalid  bool
+	}{
+		{name: "null", input: nil, expected: nil},
+		{name: "bytes", input: []byte{1, 2, 3}, expected: []byte{1, 2, 3}},
+		{name: "base64", input: "AQID", expected: []byte{1, 2, 3}},
+		{name: "base64url", input: "-_8", expected: []byte{0xfb, 0xff}},
+		{name: "structpb", input: structpb.NewStringValue("AQID"), expected: []byte{1, 2, 3}},
+		{name: "wrapper", input: wrapperspb.Bytes([]byte{9}), expected: []byte{9}},
+		{name: "max_bytes violation", input: []byte{1, 2, 3, 4, 5}, invalid: true},
+		{name: "not base64", input: "#$%", invalid: true},
+		{name: "number", input: 1, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rv := blob.New()
+			err := rv.Decode(tt.input)
+			if tt.invalid {
+				if err == nil {
+					t.Errorf("Decode(%v) = %v, want error", tt.input, rv.Interface())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode(%v) error: %v", tt.input, err)
+			}
+			if value := rv.Interface().([]byte); string(value) != string(tt.expected) {
+				t.Errorf("Decode(%v) = %v, want %v", tt.input, value, tt.expected)
+			}
+		})
+	}
+}
@@ -17,11 +17,78 @@ import (
 )
 
 type DateTime struct {
-	spec *datapb.Datetime
+	spec  *datapb.Datetime
+	zone  *time.Location // [zone] associated ; default: UTC
+	epoch time.Time      // [epoch] custom ; default: UNIX
+	err   error          // .spec.(constraints) failed
 }
 
 func DateTimeAs(spec *datapb.Datetime) Type {
-	return &DateTime{spec: spec}
+	dt := &DateTime{spec: spec}
+	dt.setup()
+	return dt
+}
+
+// sample time to check the [format] layout ; distinct from the reference one !
+var dateTimeLayoutRef = time.Date(1999, time.December, 31, 23, 59, 58, 0, time.UTC)
+
+func (dt *DateTime) setup() {
+	var err error
+	dt.zone, err = ParseTimeZone(dt.spec.GetZone())
+	if err != nil {
+		dt.err = RequestError(
+			"custom.type.datetime.zone.invalid",
+			"custom: datetime{zone} %q is neither IANA zone name nor UTC offset",
+			dt.spec.GetZone(),
+		)
+		return
+	}
+	dt.epoch = time.Unix(0, 0).UTC()
+	if since := dt.spec.GetEpoch(); since != 0 {
+		if math.IsNaN(since) || math.IsInf(since, 0) {
+			dt.err = RequestError(
+				"custom.type.datetime.epoch.invalid",
+				"custom: datetime{epoch} %v is not a timestamp",
+				since,
+			)
+			return
+		}
+		dt.epoch = CastNumberAsDateTime(since, time.Second)
+	}
+	if layout := dt.spec.GetFormat(); layout != "" {
+		// [NOTE] layout MUST contain at least one of the time element(s)
+		if dateTimeLayoutRef.Format(layout) == layout {
+			dt.err = RequestError(
+				"custom.type.datetime.format.invalid",
+				"custom: datetime{format} %q has no time layout element(s)",
+				layout,
+			)
+		}
+	}
+}
+
+// ParseTimeZone returns the time zone location by it's [name],
+// e.g.: "Europe/Kyiv" [IANA] name -or- "+03:00" UTC offset.
+// Empty [name] means UTC.
+//
+// [NOTE] IANA zones are loaded from the system database ;
+// import "time/tzdata" into the binary, if not available.
+func ParseTimeZone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	switch name {
+	case "", "UTC", "Z", "utc":
+		return time.UTC, nil
+	}
+	if c := name[0]; c == '+' || c == '-' {
+		for _, layout := range []string{"-07:00", "-0700", "-07"} {
+			if at, err := time.Parse(layout, name); err == nil {
+				_, offset := at.Zone()
+				return time.FixedZone(name, offset), nil
+			}
+		}
+		return nil, fmt.Errorf("time: invalid UTC offset %q", name)
+	}
+	return time.LoadLocation(name)
 }
 
 var _ Type = (*DateTime)(nil)
@@ -38,12 +105,140 @@ func (dt *DateTime) New() customrel.Codec {
 
 // Err to check data type descriptor integrity.
 func (dt *DateTime) Err() error {
-	// panic("not implemented")
+	if dt == nil {
+		return nil
+	}
+	if dt.err != nil {
+		return dt.err
+	}
+	if part := dt.spec.GetPart(); datapb.Datetime_Part_name[int32(part)] == "" {
+		return RequestError(
+			"custom.type.datetime.part.invalid",
+			"custom: datetime{part} %d is not supported",
+			part,
+		)
+	}
+	if stamp := dt.spec.GetStamp(); datapb.Datetime_Stamp_name[int32(stamp)] == "" {
+		return RequestError(
+			"custom.type.datetime.stamp.invalid",
+			"custom: datetime{stamp} %d is not supported",
+			stamp,
+		)
+	}
 	return nil
 }
 
 func (dt *DateTime) Custom(pragma.DoNotImplement) {}
 
+// Location of the time [zone] associated. Default: UTC.
+func (dt *DateTime) Location() *time.Location {
+	if dt != nil && dt.zone != nil {
+		return dt.zone
+	}
+	return time.UTC
+}
+
+// Epoch of the timestamp number(s). Default: UNIX epoch.
+func (dt *DateTime) Epoch() time.Time {
+	if dt != nil && !dt.epoch.IsZero() {
+		return dt.epoch
+	}
+	return time.Unix(0, 0).UTC()
+}
+
+// Part of the value: [ full | date | time ].
+func (dt *DateTime) Part() datapb.Datetime_Part {
+	if dt != nil {
+		return dt.spec.GetPart()
+	}
+	return datapb.Datetime_full
+}
+
+// Precision of the time part value.
+// Default: [time.Millisecond].
+func (dt *DateTime) Precision() time.Duration {
+	if dt != nil {
+		switch dt.spec.GetStamp() {
+		case datapb.Datetime_h:
+			return time.Hour
+		case datapb.Datetime_m:
+			return time.Minute
+		case datapb.Datetime_s:
+			return time.Second
+		case datapb.Datetime_mc:
+			return time.Microsecond
+		case datapb.Datetime_ns:
+			return time.Nanosecond
+		}
+	}
+	return time.Millisecond
+}
+
+// timestamp number unit, according to the [Precision].
+func (dt *DateTime) unit() time.Duration {
+	if pres := dt.Precision(); pres < time.Second {
+		return pres
+	}
+	return time.Second
+}
+
+// timeOnlyLayout with the fractional seconds according to the [Precision].
+func (dt *DateTime) timeOnlyLayout() string {
+	switch dt.Precision() {
+	case time.Millisecond:
+		return "15:04:05.000"
+	case time.Microsecond:
+		return "15:04:05.000000"
+	case time.Nanosecond:
+		return "15:04:05.000000000"
+	}
+	return time.TimeOnly
+}
+
+// Truncate [v] value according to the [Part] and [Precision].
+// The [date] part value is the midnight (UTC) of that day within the [zone].
+// The [time] part value is the time of day within the [zone] since UNIX epoch date (1970-01-01).
+// The [full] datetime value is the same instant within the [zone].
+func (dt *DateTime) Truncate(v time.Time) time.Time {
+	v = v.In(dt.Location())
+	switch dt.Part() {
+	case datapb.Datetime_date:
+		{
+			year, month, day := v.Date()
+			return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		}
+	case datapb.Datetime_time:
+		{
+			hour, min, sec := v.Clock()
+			v = time.Date(1970, 1, 1, hour, min, sec, v.Nanosecond(), time.UTC)
+		}
+	}
+	return v.Truncate(dt.Precision())
+}
+
+// MapValue returns [v] value representation for the [Record.AsMap] output.
+// The [date] part as "YYYY-MM-DD" string; [time] part as "HH:mm:ss[.pres]" string;
+// [full] datetime as a timestamp number of the [Precision] units (seconds at least)
+// since [Epoch] -or- as a [Display] string, if the [format] is specified.
+func (dt *DateTime) MapValue(v time.Time) any {
+	v = dt.Truncate(v)
+	switch dt.Part() {
+	case datapb.Datetime_date:
+		return v.Format(time.DateOnly)
+	case datapb.Datetime_time:
+		return v.Format(dt.timeOnlyLayout())
+	}
+	if dt.spec.GetFormat() != "" {
+		return dt.Display(v)
+	}
+	return CastDateTimeAsNumber(v, dt.unit(), dt.Epoch())
+}
+
+// Display [v] value as a string of the [Format] layout within the [zone].
+func (dt *DateTime) Display(v time.Time) string {
+	return v.In(dt.Location()).Format(dt.Format())
+}
+
 // Format returns preconfigured input/output layout string
 func (dt *DateTime) Format() string {
 	if dt != nil {
@@ -52,6 +247,12 @@ func (dt *DateTime) Format() string {
 			return layout
 		}
 	}
+	switch dt.Part() {
+	case datapb.Datetime_date:
+		return time.DateOnly
+	case datapb.Datetime_time:
+		return dt.timeOnlyLayout()
+	}
 	// "2006-01-02 15:04:05"
 	return time.DateTime
 }
@@ -99,6 +300,10 @@ func (dv *DateTimeValue) Interface() any {
 
 func (dv *DateTimeValue) Decode(src any) error {
 	setValue := func(set *time.Time) (err error) {
+		if set != nil && !set.IsZero() {
+			date := dv.typof.Truncate(*set)
+			set = &date
+		}
 		err = dv.typof.Accept(set)
 		if err != nil {
 			return // [ERR]
@@ -120,14 +325,14 @@ func (dv *DateTimeValue) Decode(src any) error {
 		if set == nil {
 			return setValue(nil)
 		}
-		date := CastNumberAsDateTime(*set, time.Millisecond)
+		date := CastNumberAsDateTime(*set, dv.typof.unit(), dv.typof.Epoch())
 		return setValue(&date)
 	}
 	setDouble := func(set *float64) error {
 		if set == nil {
 			return setValue(nil)
 		}
-		date := CastNumberAsDateTime(*set, time.Millisecond)
+		date := CastNumberAsDateTime(*set, dv.typof.unit(), dv.typof.Epoch())
 		return setValue(&date)
 	}
 	setString := func(set *string) error {
@@ -151,8 +356,12 @@ func (dv *DateTimeValue) Decode(src any) error {
 			time.RFC3339,
 			time.RFC3339Nano,
 			time.DateTime,
+			time.DateOnly,
+			time.TimeOnly,
+			"15:04", // HH:mm
 		} {
-			date, err = time.Parse(layout, text)
+			// [NOTE] input without UTC offset is within the [zone]
+			date, err = time.ParseInLocation(layout, text, dv.typof.Location())
 			if err == nil {
 				return setValue(&date)
 			}
@@ -327,8 +536,10 @@ func (dv *DateTimeValue) Encode(dst any) error {
 // Err to check underlying value
 // according to the data type constraints
 func (dv *DateTimeValue) Err() error {
-	return nil
-	panic("not implemented")
+	if dv == nil || dv.value == nil {
+		return nil // NULL
+	}
+	return dv.typof.Accept(dv.value)
 }
 
 func (dv *DateTimeValue) Custom(pragma.DoNotImplement) {}
@@ -337,11 +548,13 @@ func (dv *DateTimeValue) Custom(pragma.DoNotImplement) {}
 // into a time.Time value with the specified precision.
 // It accepts both int64 and float64 input types, automatically inferring the unit based on magnitude.
 //
+// The optional [epoch] is the custom reference time of the timestamp ; default: UNIX epoch.
+//
 // Note: When using float64 to represent timestamps (especially large ones), you may lose precision
 // due to limitations in IEEE-754 binary floating-point representation. For example, float64 can
 // precisely represent only about 15–17 decimal digits, which is not enough for nanosecond precision
 // on large epoch timestamps. Prefer int64 for precise time values whenever possible.
-func CastNumberAsDateTime[T int64 | float64](v T, pres time.Duration) time.Time {
+func CastNumberAsDateTime[T int64 | float64](v T, pres time.Duration, epoch ...time.Time) time.Time {
 	var ns int64
 
 	// Choose precision based on magnitude
@@ -359,10 +572,10 @@ func CastNumberAsDateTime[T int64 | float64](v T, pres time.Duration) time.Time
 		tsec := int64(v)
 		nsec := int64(float64(v)*float64(toNsec)) % toNsec
 
-		return time.Unix(tsec, nsec*int64(pres)).UTC()
+		return castEpoch(time.Unix(tsec, nsec*int64(pres)).UTC(), epoch, false)
 	}
 
-	return time.Unix(ns/1e9, ns%1e9).UTC().Truncate(pres)
+	return castEpoch(time.Unix(ns/1e9, ns%1e9).UTC().Truncate(pres), epoch, false)
 }
 
 // CastDateTimeAsNumber converts a time.Time value into an int64 timestamp "tsec[.pres]",
@@ -373,6 +586,21 @@ func CastNumberAsDateTime[T int64 | float64](v T, pres time.Duration) time.Time
 //   - time.Millisecond
 //   - time.Microsecond
 //   - time.Nanosecond
-func CastDateTimeAsNumber(v time.Time, pres time.Duration) int64 {
-	return v.UnixNano() / int64(pres)
+//
+// The optional [epoch] is the custom reference time of the timestamp ; default: UNIX epoch.
+func CastDateTimeAsNumber(v time.Time, pres time.Duration, epoch ...time.Time) int64 {
+	return castEpoch(v, epoch, true).UnixNano() / int64(pres)
+}
+
+// castEpoch shifts UNIX-based [v] time to the custom [epoch], if any.
+// The [inverse] shifts custom [epoch]-based time to UNIX one.
+func castEpoch(v time.Time, epoch []time.Time, inverse bool) time.Time {
+	if len(epoch) == 0 || epoch[0].IsZero() {
+		return v
+	}
+	delta := epoch[0].Sub(time.Unix(0, 0))
+	if inverse {
+		delta = -delta
+	}
+	return v.Add(delta)
 }
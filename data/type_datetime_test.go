@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	datapb "github.com/webitel/proto/gen/custom/data"
 )
 
 func TestCastNumberAsDateTime(t *testing.T) {
@@ -178,3 +180,77 @@ func TestCastDateTimeAsNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestDateTimePart(t *testing.T) {
+	tests := []struct {
+		spec  *datapb.Datetime
+		input any
+		want  any // MapValue
+	}{
+		{&datapb.Datetime{Part: datapb.Datetime_date}, "2024-11-18 17:37:43", "2024-11-18"},
+		{&datapb.Datetime{Part: datapb.Datetime_date}, "2024-11-18", "2024-11-18"},
+		{&datapb.Datetime{Part: datapb.Datetime_time}, "17:37:43.527302", "17:37:43.527"},
+		{&datapb.Datetime{Part: datapb.Datetime_time, Stamp: datapb.Datetime_s}, "17:37:43.527", "17:37:43"},
+		{&datapb.Datetime{Part: datapb.Datetime_time, Stamp: datapb.Datetime_m}, "17:37", "17:37:00"},
+		{&datapb.Datetime{}, int64(1731951463_527302), int64(1731951463_527)},
+		{&datapb.Datetime{Stamp: datapb.Datetime_s}, "2024-11-18T17:37:43.527Z", int64(1731951463)},
+		{&datapb.Datetime{Stamp: datapb.Datetime_mc}, int64(1731951463_527302), int64(1731951463_527302)},
+		{&datapb.Datetime{Stamp: datapb.Datetime_h}, int64(1731951463), int64(1731949200)},
+	}
+	for _, tt := range tests {
+		dt := DateTimeAs(tt.spec).(*DateTime)
+		rv := dt.New()
+		if err := rv.Decode(tt.input); err != nil {
+			t.Errorf("DateTime{%v}.Decode(%v) error = %v", tt.spec, tt.input, err)
+			continue
+		}
+		got := dt.MapValue(*rv.Interface().(*time.Time))
+		if got != tt.want {
+			t.Errorf("DateTime{%v}.Decode(%v) = %v, want %v", tt.spec, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDateTimeZone(t *testing.T) {
+	dt := DateTimeAs(&datapb.Datetime{
+		Zone: "+02:00", Epoch: 1e9, Format: "02.01.2006 15:04",
+	}).(*DateTime)
+	if err := dt.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	want := time.Date(2024, 11, 18, 8, 0, 0, 0, time.UTC)
+	for _, input := range []any{
+		"18.11.2024 10:00",             // [format] within [zone]
+		"2024-11-18 10:00:00",          // no offset ; within [zone]
+		"2024-11-18T08:00:00Z",         // offset specified
+		want.Sub(dt.Epoch()).Seconds(), // since custom [epoch]
+	} {
+		rv := dt.New()
+		if err := rv.Decode(input); err != nil {
+			t.Errorf("Decode(%v) error = %v", input, err)
+			continue
+		}
+		if got := *rv.Interface().(*time.Time); !got.Equal(want) {
+			t.Errorf("Decode(%v) = %v, want %v", input, got, want)
+		}
+	}
+	if got := dt.MapValue(want); got != "18.11.2024 10:00" {
+		t.Errorf("MapValue() = %v, want 18.11.2024 10:00", got)
+	}
+	if got := CastDateTimeAsNumber(want, time.Second, dt.Epoch()); got != want.Unix()-1e9 {
+		t.Errorf("CastDateTimeAsNumber(epoch) = %d, want %d", got, want.Unix()-1e9)
+	}
+
+	date := DateTimeAs(&datapb.Datetime{Zone: "-05:00", Part: datapb.Datetime_date}).(*DateTime)
+	if got := date.MapValue(time.Date(2024, 11, 18, 2, 0, 0, 0, time.UTC)); got != "2024-11-17" {
+		t.Errorf("date.MapValue() = %v, want 2024-11-17", got)
+	}
+
+	for _, spec := range []*datapb.Datetime{
+		{Zone: "Nowhere/City"}, {Zone: "+25:00"}, {Format: "date"},
+	} {
+		if DateTimeAs(spec).Err() == nil {
+			t.Errorf("DateTimeAs(%v).Err() = nil, want invalid", spec)
+		}
+	}
+}
@@ -0,0 +1,510 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/webitel/custom/internal/pragma"
+	customrel "github.com/webitel/custom/reflect"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Decimal fixed-point (exact) number type.
+// Also represents the [MONEY] amount of currency.
+type Decimal struct {
+	kind  customrel.Kind  // [ DECIMAL | MONEY ]
+	spec  *datapb.Decimal // descriptor: constraints
+	scale int             // fractional digits ; -1 arbitrary
+	min   *big.Rat
+	max   *big.Rat
+	err   error // .spec.(constraints) failed
+}
+
+// DecimalAs number type.
+func DecimalAs(spec *datapb.Decimal) Type {
+	return decimalAs(customrel.DECIMAL, spec)
+}
+
+// MoneyAs amount of currency type.
+func MoneyAs(spec *datapb.Decimal) Type {
+	return decimalAs(customrel.MONEY, spec)
+}
+
+func decimalAs(kind customrel.Kind, spec *datapb.Decimal) *Decimal {
+	dt := &Decimal{
+		kind: kind,
+		spec: spec,
+	}
+	dt.setup()
+	return dt
+}
+
+// maximum precision of the PostgreSQL numeric type modifier
+const decimalMaxPrecision = 1000
+
+func (dt *Decimal) setup() {
+	var (
+		spec      = dt.spec
+		precision = spec.GetPrecision()
+		scale     = spec.GetScale()
+	)
+	dt.scale = -1 // arbitrary
+	if precision > decimalMaxPrecision {
+		dt.err = RequestError(
+			fmt.Sprintf("custom.type.%s.precision.invalid", dt.kind),
+			"custom: %s{precision} %d exceeds max of: %d digits",
+			dt.kind, precision, decimalMaxPrecision,
+		)
+		return
+	}
+	if 0 < precision && precision < scale {
+		dt.err = RequestError(
+			fmt.Sprintf("custom.type.%s.scale.invalid", dt.kind),
+			"custom: %s{scale} %d exceeds precision of: %d digits",
+			dt.kind, scale, precision,
+		)
+		return
+	}
+	if 0 < precision || 0 < scale {
+		dt.scale = int(scale)
+	}
+	if dt.kind == customrel.MONEY {
+		code := spec.GetCurrency()
+		if code == "" {
+			dt.err = RequestError(
+				"custom.type.money.currency.required",
+				"custom: money{currency} required but missing",
+			)
+			return
+		}
+		digits, ok := CurrencyMinorUnits(code)
+		if !ok {
+			dt.err = RequestError(
+				"custom.type.money.currency.invalid",
+				"custom: money{currency} %q is not ISO-4217 code",
+				code,
+			)
+			return
+		}
+		if scale == 0 {
+			// default ; the currency minor units, e.g.: cents
+			if 0 < precision && precision < digits {
+				dt.err = RequestError(
+					"custom.type.money.scale.invalid",
+					"custom: money{scale} %d of %s exceeds precision of: %d digits",
+					digits, code, precision,
+				)
+				return
+			}
+			dt.scale = int(digits)
+		}
+	}
+	for _, bound := range []struct {
+		name string
+		spec string
+		into **big.Rat
+	}{
+		{"min", spec.GetMin(), &dt.min},
+		{"max", spec.GetMax(), &dt.max},
+	} {
+		if bound.spec == "" {
+			continue // unbound
+		}
+		v, ok := parseDecimal(bound.spec)
+		if !ok {
+			dt.err = RequestError(
+				fmt.Sprintf("custom.type.%s.%s.invalid", dt.kind, bound.name),
+				"custom: %s{%s} %q is not a decimal number",
+				dt.kind, bound.name, bound.spec,
+			)
+			return
+		}
+		*(bound.into) = v
+	}
+	if dt.min != nil && dt.max != nil && dt.max.Cmp(dt.min) < 0 {
+		dt.err = RequestError(
+			fmt.Sprintf("custom.type.%s.max.invalid", dt.kind),
+			"custom: %s{max} %s is less than min of: %s",
+			dt.kind, dt.spec.GetMax(), dt.spec.GetMin(),
+		)
+	}
+}
+
+var _ Type = (*Decimal)(nil)
+
+// Kind of the data type.
+func (dt *Decimal) Kind() customrel.Kind {
+	if dt != nil {
+		return dt.kind
+	}
+	return customrel.DECIMAL
+}
+
+// New data value codec.
+func (dt *Decimal) New() customrel.Codec {
+	return &DecimalValue{typof: dt}
+}
+
+// Err to check data type descriptor integrity.
+func (dt *Decimal) Err() error {
+	if dt != nil {
+		return dt.err
+	}
+	return nil
+}
+
+func (*Decimal) Custom(pragma.DoNotImplement) {}
+
+// Precision is the total count of significant digits.
+// Zero means arbitrary precision.
+func (dt *Decimal) Precision() int {
+	if dt != nil {
+		return int(dt.spec.GetPrecision())
+	}
+	return 0
+}
+
+// Scale is the count of the fractional part digits.
+// Negative means arbitrary scale.
+func (dt *Decimal) Scale() int {
+	if dt != nil {
+		return dt.scale
+	}
+	return -1
+}
+
+// Currency ISO-4217 code of the [MONEY] amount.
+func (dt *Decimal) Currency() string {
+	if dt != nil && dt.kind == customrel.MONEY {
+		return strings.ToUpper(dt.spec.GetCurrency())
+	}
+	return ""
+}
+
+// Format [v] value as a decimal string
+// with exactly [Scale] fractional digits, if defined.
+func (dt *Decimal) Format(v *big.Rat) string {
+	return decimalString(v, dt.Scale())
+}
+
+var decimalViolations = map[string]string{
+	"precision": "{kind} {value} violates precision of: {precision} digits",
+	"scale":     "{kind} {value} violates scale of: {scale} fractional digits",
+	"min":       "{kind} {value} violates min boundary of: {min}",
+	"max":       "{kind} {value} violates max boundary of: {max}",
+}
+
+func (dt *Decimal) violationError(kind string, val *big.Rat) error {
+	return violationError(dt.kind.String(), kind, dt.spec.GetViolation(), decimalViolations, violationArgs{
+		"kind":      dt.kind.String(),
+		"value":     decimalString(val, -1),
+		"precision": strconv.Itoa(dt.Precision()),
+		"scale":     strconv.Itoa(dt.Scale()),
+		"min":       dt.spec.GetMin(),
+		"max":       dt.spec.GetMax(),
+	})
+}
+
+// Accept typical value type constraints
+func (dt *Decimal) Accept(val *big.Rat) error {
+	if dt == nil {
+		// no constraints
+		return nil // [OK] ; whatever ..
+	}
+	if dt.err != nil {
+		// invalid type descriptor
+		return dt.err
+	}
+	if val == nil {
+		return nil // [OK] ; NULL
+	}
+	whole, frac := decimalDigits(val)
+	if scale := dt.scale; 0 <= scale && (frac < 0 || scale < frac) {
+		return dt.violationError("scale", val)
+	}
+	if precision := dt.Precision(); 0 < precision && (precision-dt.scale) < whole {
+		return dt.violationError("precision", val)
+	}
+	if dt.min != nil && val.Cmp(dt.min) < 0 {
+		return dt.violationError("min", val)
+	}
+	if dt.max != nil && val.Cmp(dt.max) > 0 {
+		return dt.violationError("max", val)
+	}
+	return nil // [OK]
+}
+
+// parseDecimal number from string, e.g.: "-12.50", "1e-3".
+func parseDecimal(s string) (*big.Rat, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.ContainsRune(s, '/') {
+		// [NOTE] big.Rat accepts "a/b" fraction
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// decimalDigits returns count of the [whole] and [frac]tional part digits.
+// The [frac] is negative for the infinite fraction, e.g.: 1/3.
+func decimalDigits(v *big.Rat) (whole, frac int) {
+	num := new(big.Int).Abs(v.Num())
+	if q := num.Quo(num, v.Denom()); q.Sign() != 0 {
+		whole = len(q.String())
+	}
+	if v.IsInt() {
+		return whole, 0
+	}
+	var (
+		twos, fives int
+		rem         = new(big.Int)
+		den         = new(big.Int).Set(v.Denom())
+		five        = big.NewInt(5)
+	)
+	for den.Bit(0) == 0 {
+		den.Rsh(den, 1)
+		twos++
+	}
+	for {
+		q, r := new(big.Int).QuoRem(den, five, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		den = q
+		fives++
+	}
+	if !den.IsInt64() || den.Int64() != 1 {
+		return whole, -1 // infinite
+	}
+	return whole, max(twos, fives)
+}
+
+// decimalString returns [v] value as a decimal string
+// with the given [scale] of fractional digits.
+// Negative [scale] means the exact (shortest) representation.
+func decimalString(v *big.Rat, scale int) string {
+	if scale < 0 {
+		if _, scale = decimalDigits(v); scale < 0 {
+			scale = 16 // infinite fraction ; truncated
+		}
+	}
+	return v.FloatString(scale)
+}
+
+// DecimalValue represents an exact decimal number
+type DecimalValue struct {
+	typof *Decimal
+	value *big.Rat // NULL(-able)
+}
+
+var _ customrel.Codec = (*DecimalValue)(nil)
+
+// Interface of the [*big.Rat] value.
+func (dv *DecimalValue) Interface() any {
+	if dv != nil {
+		return dv.value
+	}
+	return (*big.Rat)(nil)
+}
+
+// Type of the data value
+func (dv *DecimalValue) Type() customrel.Type {
+	if dv != nil {
+		return dv.typof
+	}
+	return (*Decimal)(nil)
+}
+
+// Err to check underlying value
+// according to the data type constraints
+func (dv *DecimalValue) Err() error {
+	if dv.IsNull() {
+		return nil
+	}
+	return dv.typof.Accept(dv.value)
+}
+
+// implements [Nullable] interface
+func (dv *DecimalValue) IsNull() bool {
+	return dv == nil || dv.value == nil
+}
+
+func (dv *DecimalValue) IsZero() bool {
+	return !dv.IsNull() && dv.value.Sign() == 0
+}
+
+// String formats the underlying value
+// according to the data type [Scale].
+func (dv *DecimalValue) String() string {
+	if dv.IsNull() {
+		return ""
+	}
+	return dv.typof.Format(dv.value)
+}
+
+func (dv *DecimalValue) Decode(src any) error {
+	setValue := func(set *big.Rat) (err error) {
+		if set != nil {
+			set = new(big.Rat).Set(set) // copy
+		}
+		err = dv.typof.Accept(set)
+		if err == nil {
+			dv.value = set
+		}
+		return // err
+	}
+	stringValue := func(src *string) error {
+		if src == nil {
+			return setValue(nil) // NULL
+		}
+		input := strings.TrimSpace(*src)
+		if input == "" {
+			return setValue(nil) // NULL
+		}
+		// [MONEY] amount MAY be followed -or- preceded
+		// by it's own currency code, e.g.: "10.50 USD"
+		if code := dv.typof.Currency(); code != "" && len(input) > len(code) {
+			if n := len(input) - len(code); strings.EqualFold(input[n:], code) {
+				input = strings.TrimSpace(input[0:n])
+			} else if strings.EqualFold(input[0:len(code)], code) {
+				input = strings.TrimSpace(input[len(code):])
+			}
+		}
+		set, ok := parseDecimal(input)
+		if !ok {
+			return fmt.Errorf("convert: string %q value into %s", *src, dv.typof.Kind())
+		}
+		return setValue(set)
+	}
+	floatValue := func(src float64, bits int) error {
+		if math.IsNaN(src) || math.IsInf(src, 0) {
+			return fmt.Errorf("convert: float %v value into %s", src, dv.typof.Kind())
+		}
+		// shortest representation ; e.g.: 0.1 => "0.1"
+		set, _ := new(big.Rat).SetString(
+			strconv.FormatFloat(src, 'f', -1, bits),
+		)
+		return setValue(set)
+	}
+	// accept: src.(type)
+	if src == nil {
+		return setValue(nil)
+	}
+	switch data := src.(type) {
+	case DecimalValue:
+		{
+			return setValue(data.value)
+		}
+	case *DecimalValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			if data == dv {
+				return nil // SELF
+			}
+			return setValue(data.value)
+		}
+	case *big.Rat:
+		{
+			return setValue(data)
+		}
+	case *big.Int:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return setValue(new(big.Rat).SetInt(data))
+		}
+	case string:
+		{
+			return stringValue(&data)
+		}
+	case *string:
+		{
+			return stringValue(data)
+		}
+	case []byte:
+		{
+			text := string(data)
+			return stringValue(&text)
+		}
+	case json.Number:
+		{
+			text := string(data)
+			return stringValue(&text)
+		}
+	case int:
+		return setValue(new(big.Rat).SetInt64(int64(data)))
+	case int32:
+		return setValue(new(big.Rat).SetInt64(int64(data)))
+	case int64:
+		return setValue(new(big.Rat).SetInt64(data))
+	case uint:
+		return setValue(new(big.Rat).SetUint64(uint64(data)))
+	case uint32:
+		return setValue(new(big.Rat).SetUint64(uint64(data)))
+	case uint64:
+		return setValue(new(big.Rat).SetUint64(data))
+	case float32:
+		return floatValue(float64(data), 32)
+	case float64:
+		return floatValue(data, 64)
+	case *structpb.Value:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			switch kind := data.Kind.(type) {
+			case nil, *structpb.Value_NullValue:
+				{
+					return setValue(nil) // NULL
+				}
+			case *structpb.Value_NumberValue:
+				{
+					return floatValue(kind.NumberValue, 64)
+				}
+			case *structpb.Value_StringValue:
+				{
+					return stringValue(&kind.StringValue)
+				}
+			}
+		}
+	case *wrapperspb.StringValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return stringValue(&data.Value)
+		}
+	case *wrapperspb.DoubleValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return floatValue(data.Value, 64)
+		}
+	case *wrapperspb.Int64Value:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return setValue(new(big.Rat).SetInt64(data.Value))
+		}
+	}
+	return fmt.Errorf(
+		"convert: %[1]T value %[1]v into %[2]s",
+		src, dv.typof.Kind(),
+	)
+}
+
+func (dv *DecimalValue) Encode(dst any) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (*DecimalValue) Custom(pragma.DoNotImplement) {}
@@ -0,0 +1,92 @@
+package data
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDecimalValue(t *testing.T) {
+	price := DecimalAs(&datapb.Decimal{Precision: 6, Scale: 2, Min: "0"}).(*Decimal)
+	cost := MoneyAs(&datapb.Decimal{Currency: "uah"}).(*Decimal)
+	// precision only ; the currency minor units scale
+	amount := MoneyAs(&datapb.Decimal{Currency: "USD", Precision: 12}).(*Decimal)
+
+	tests := []struct {
+		typof *Decimal
+		input any
+		want  string // decimal ; or error id
+	}{
+		{price, "12.5", "12.50"},
+		{price, 0.1, "0.10"},
+		{price, structpb.NewNumberValue(9999.99), "9999.99"},
+		{price, structpb.NewStringValue("1e2"), "100.00"},
+		{price, int64(42), "42.00"},
+		{price, "", ""},
+		{price, "0.001", "custom.type.decimal.scale.violation"},
+		{price, "10000", "custom.type.decimal.precision.violation"},
+		{price, "-1", "custom.type.decimal.min.violation"},
+		{price, "1/3", "convert"},
+		{cost, "10.5 UAH", "10.50"},
+		{cost, "USD 10.5", "convert"},
+		{cost, "0.015", "custom.type.money.scale.violation"},
+		{amount, "19.99", "19.99"},
+		{amount, "0.005", "custom.type.money.scale.violation"},
+		{amount, "12345678901", "custom.type.money.precision.violation"},
+	}
+	for _, tt := range tests {
+		rv := tt.typof.New().(*DecimalValue)
+		err := rv.Decode(tt.input)
+		if err != nil {
+			var re *Error
+			if errors.As(err, &re) {
+				err = errors.New(re.Id)
+			} else {
+				err = errors.New("convert")
+			}
+			if err.Error() != tt.want {
+				t.Errorf("%s.Decode(%v) error = %v, want %s", tt.typof.Kind(), tt.input, err, tt.want)
+			}
+			continue
+		}
+		if got := rv.String(); got != tt.want {
+			t.Errorf("%s.Decode(%v) = %s, want %s", tt.typof.Kind(), tt.input, got, tt.want)
+		}
+	}
+
+	for _, spec := range []*datapb.Decimal{
+		{Precision: 2, Scale: 3},
+		{Min: "1", Max: "0"},
+		{Max: "ten"},
+	} {
+		if DecimalAs(spec).Err() == nil {
+			t.Errorf("DecimalAs(%v).Err() = nil, want invalid", spec)
+		}
+	}
+	if amount.Scale() != 2 {
+		t.Errorf("MoneyAs(USD, precision: 12).Scale() = %d, want 2", amount.Scale())
+	}
+	if MoneyAs(&datapb.Decimal{Currency: "USD", Precision: 1}).Err() == nil {
+		t.Errorf("MoneyAs(USD, precision: 1).Err() = nil, want invalid scale")
+	}
+	if MoneyAs(&datapb.Decimal{Currency: "XYZ"}).Err() == nil {
+		t.Errorf("MoneyAs(XYZ).Err() = nil, want invalid currency")
+	}
+}
+
+func TestDecimalDigits(t *testing.T) {
+	for input, want := range map[string][2]int{
+		"0": {0, 0}, "-12.345": {2, 3}, "0.5": {0, 1}, "1e-3": {0, 3}, "1000": {4, 0},
+	} {
+		v, _ := new(big.Rat).SetString(input)
+		if whole, frac := decimalDigits(v); whole != want[0] || frac != want[1] {
+			t.Errorf("decimalDigits(%s) = (%d, %d), want %v", input, whole, frac, want)
+		}
+	}
+	if _, frac := decimalDigits(big.NewRat(1, 3)); frac != -1 {
+		t.Errorf("decimalDigits(1/3) frac = %d, want -1", frac)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,26 +41,82 @@ func (dt *Duration) New() customrel.Codec {
 
 // Err to check data type descriptor integrity.
 func (dt *Duration) Err() error {
-	// [TODO]
+	if dt == nil || dt.spec == nil {
+		return nil // no constraints
+	}
+	if min, max := dt.spec.Min, dt.spec.Max; min != nil && max != nil && max.Value < min.Value {
+		return RequestError(
+			"custom.type.duration.max.invalid",
+			"custom: duration{max} %d is less than min of: %d",
+			max.Value, min.Value,
+		)
+	}
+	if _, ok := durationFormats[dt.spec.Format]; !ok {
+		return RequestError(
+			"custom.type.duration.format.invalid",
+			"custom: duration{format} %q is not supported",
+			dt.spec.Format,
+		)
+	}
 	return nil
-	panic("not implemented")
 }
 
 func (*Duration) Custom(pragma.DoNotImplement) {}
 
-var durationError = map[string]string{
-	"min": "duration value {{.value}} violates min boundary of {{.min}}",
-	"max": "duration value {{.value}} violates max boundary of {{.max}}",
+// Duration display format(s).
+const (
+	DurationSeconds  = ""         // number of seconds, e.g.: 5400.25
+	DurationISO8601  = "iso8601"  // e.g.: PT1H30M0.25S
+	DurationGo       = "go"       // e.g.: 1h30m0.25s
+	DurationClock    = "clock"    // e.g.: 01:30:00.250
+	DurationInterval = "interval" // PostgreSQL, e.g.: 01:30:00.25
+)
+
+// durationFormats supported. map[format]render
+var durationFormats = map[string]func(time.Duration) string{
+	DurationSeconds:  nil, // number
+	DurationISO8601:  FormatDurationISO8601,
+	DurationGo:       time.Duration.String,
+	DurationClock:    FormatDurationClock,
+	DurationInterval: FormatDurationInterval,
+	// aliases
+	"iso":         FormatDurationISO8601,
+	"hh:mm:ss":    FormatDurationClock,
+	"hh:mm:ss.ms": FormatDurationClock,
+}
+
+// Format of the value display.
+func (dt *Duration) Format() string {
+	if dt != nil {
+		return dt.spec.GetFormat()
+	}
+	return DurationSeconds
+}
+
+// MapValue returns [v] value representation for the [Record.AsMap] output,
+// according to the display [Format]: number of seconds, by default.
+func (dt *Duration) MapValue(v time.Duration) any {
+	if render := durationFormats[dt.Format()]; render != nil {
+		return render(v)
+	}
+	return CastDurationAsNumber(v)
+}
+
+var durationViolations = map[string]string{
+	"min": "duration value {value} violates min boundary of {min}",
+	"max": "duration value {value} violates max boundary of {max}",
 }
 
 func (dt *Duration) violationError(kind string, val *time.Duration) error {
-	tmpl := durationError[kind]
-	tmpl = strings.ReplaceAll(tmpl, "{{.value}}", fmt.Sprintf("%v", val))
-	tmpl = strings.ReplaceAll(tmpl, "{{.min}}", fmt.Sprintf("%v", time.Duration(dt.spec.Min.GetValue()*int64(time.Second))))
-	tmpl = strings.ReplaceAll(tmpl, "{{.max}}", fmt.Sprintf("%v", time.Duration(dt.spec.Max.GetValue()*int64(time.Second))))
-	return RequestError(
-		fmt.Sprintf("custom.type.duration.%s.violation", kind), tmpl,
-	)
+	value := "NULL"
+	if val != nil {
+		value = val.String()
+	}
+	return violationError("duration", kind, dt.spec.GetViolation(), durationViolations, violationArgs{
+		"value": value,
+		"min":   (time.Duration(dt.spec.GetMin().GetValue()) * time.Second).String(),
+		"max":   (time.Duration(dt.spec.GetMax().GetValue()) * time.Second).String(),
+	})
 }
 
 // Accept checks value type constraints ..
@@ -155,7 +212,19 @@ func (dv *DurationValue) Decode(src any) error {
 		case "", "0", "0s":
 			return setValue(&dur) // Zero(0)
 		}
-		// Postgres-style ; REFACTOR !
+		// ISO-8601 ; PT1H30M
+		if dur, err = ParseDurationISO8601(text); err == nil {
+			return setValue(&dur) // [OK]
+		}
+		// Clock ; 01:30:00.250
+		if dur, err = ParseDurationClock(text); err == nil {
+			return setValue(&dur) // [OK]
+		}
+		// GoLang-style ; 1h30m
+		if dur, err = time.ParseDuration(text); err == nil {
+			return setValue(&dur) // [OK]
+		}
+		// Postgres-style ; 1 day 02:30:00
 		var interval pgtype.Interval
 		if err = interval.Scan(text); err == nil && interval.Valid {
 			// https://github.com/jackc/pgx/blob/v5.7.4/pgtype/builtin_wrappers.go#L507
@@ -174,9 +243,9 @@ func (dv *DurationValue) Decode(src any) error {
 			)
 			return setValue(&dur) // [OK]
 		}
-		// GoLang-style
-		if dur, err = time.ParseDuration(text); err == nil {
-			return setValue(&dur) // [OK]
+		// Number of seconds ; 5400.25
+		if sec, err := strconv.ParseFloat(text, 64); err == nil {
+			return setDouble(&sec)
 		}
 		err = RequestError(
 			"custom.type.duration.cast.error",
@@ -337,7 +406,6 @@ func (dv *DurationValue) Decode(src any) error {
 			)
 		}
 	}
-	panic("unreachable code")
 }
 
 // Encode implements customrel.Codec.
@@ -356,7 +424,10 @@ func (dv *DurationValue) Err() (err error) {
 			return // [ERR]
 		}
 	}
-	return // [OK]
+	if dv.value != nil {
+		err = dv.typof.Accept(dv.value)
+	}
+	return // err?
 }
 
 // Interface of the [*time.Duration] value.
@@ -394,3 +465,152 @@ func CastNumberAsDuration(num float64, pres time.Duration) time.Duration {
 func CastDurationAsNumber(v time.Duration) float64 {
 	return v.Seconds()
 }
+
+// ParseDurationISO8601 parses ISO-8601 duration string, e.g.: "PT1H30M", "-P1DT0.5S".
+// Calendar units are approximated: year as 365 days, month as 30 days, week as 7 days.
+func ParseDurationISO8601(s string) (time.Duration, error) {
+	text := strings.ToUpper(strings.TrimSpace(s))
+	sign := time.Duration(1)
+	if strings.HasPrefix(text, "-") {
+		sign, text = -1, text[1:]
+	}
+	if len(text) < 2 || text[0] != 'P' {
+		return 0, fmt.Errorf("time: invalid ISO-8601 duration %q", s)
+	}
+	const day = 24 * time.Hour
+	var (
+		dur   float64
+		num   = -1 // number start offset
+		clock bool // [T]ime designator passed
+		units = map[bool]map[byte]time.Duration{
+			false: {'Y': 365 * day, 'M': 30 * day, 'W': 7 * day, 'D': day},
+			true:  {'H': time.Hour, 'M': time.Minute, 'S': time.Second},
+		}
+	)
+	for i := 1; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case ('0' <= c && c <= '9') || c == '.' || c == ',':
+			if num < 0 {
+				num = i
+			}
+			continue
+		case c == 'T' && !clock && num < 0:
+			clock = true
+			continue
+		}
+		unit, ok := units[clock][c]
+		if !ok || num < 0 {
+			return 0, fmt.Errorf("time: invalid ISO-8601 duration %q", s)
+		}
+		n, err := strconv.ParseFloat(strings.Replace(text[num:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("time: invalid ISO-8601 duration %q", s)
+		}
+		dur += n * float64(unit)
+		num = -1
+	}
+	if num >= 0 || text[len(text)-1] == 'T' {
+		// trailing number without unit designator
+		return 0, fmt.Errorf("time: invalid ISO-8601 duration %q", s)
+	}
+	return sign * time.Duration(math.Round(dur)), nil
+}
+
+// FormatDurationISO8601 returns ISO-8601 duration string, e.g.: "PT1H30M0.25S".
+// Hours are not carried over to the calendar days.
+func FormatDurationISO8601(v time.Duration) string {
+	if v == 0 {
+		return "PT0S"
+	}
+	var text strings.Builder
+	if v < 0 {
+		text.WriteByte('-')
+		v = -v
+	}
+	text.WriteString("PT")
+	if h := v / time.Hour; h > 0 {
+		text.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+		v -= h * time.Hour
+	}
+	if m := v / time.Minute; m > 0 {
+		text.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+		v -= m * time.Minute
+	}
+	if v > 0 {
+		text.WriteString(strconv.FormatFloat(v.Seconds(), 'f', -1, 64) + "S")
+	}
+	return text.String()
+}
+
+// ParseDurationClock parses clock duration string, e.g.: "01:30:00.250", "1:30", "-00:00:05".
+// Format: [-]hh:mm[:ss[.frac]] ; hours are not limited to 24.
+func ParseDurationClock(s string) (time.Duration, error) {
+	text := strings.TrimSpace(s)
+	sign := time.Duration(1)
+	if strings.HasPrefix(text, "-") {
+		sign, text = -1, text[1:]
+	}
+	part := strings.Split(text, ":")
+	if n := len(part); n < 2 || n > 3 {
+		return 0, fmt.Errorf("time: invalid clock duration %q", s)
+	}
+	var dur time.Duration
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if i == len(part) {
+			break
+		}
+		digits := part[i]
+		if digits == "" || (i > 0 && len(strings.SplitN(digits, ".", 2)[0]) != 2) {
+			return 0, fmt.Errorf("time: invalid clock duration %q", s)
+		}
+		if i < 2 {
+			n, err := strconv.ParseUint(digits, 10, 32)
+			if err != nil || (i > 0 && n > 59) {
+				return 0, fmt.Errorf("time: invalid clock duration %q", s)
+			}
+			dur += time.Duration(n) * unit
+			continue
+		}
+		sec, err := strconv.ParseFloat(digits, 64)
+		if err != nil || sec < 0 || sec >= 60 || strings.ContainsAny(digits, "eE+-") {
+			return 0, fmt.Errorf("time: invalid clock duration %q", s)
+		}
+		dur += time.Duration(math.Round(sec * float64(time.Second)))
+	}
+	return sign * dur, nil
+}
+
+// FormatDurationClock returns clock duration string, e.g.: "01:30:00.250".
+// The [.ms] fraction is omitted if zero.
+func FormatDurationClock(v time.Duration) string {
+	text, frac := formatDurationClock(v)
+	if ms := frac / time.Millisecond; ms > 0 {
+		text += fmt.Sprintf(".%03d", ms)
+	}
+	return text
+}
+
+// FormatDurationInterval returns PostgreSQL interval output string, e.g.: "01:30:00.25".
+// The fraction is up to microseconds, omitted if zero.
+func FormatDurationInterval(v time.Duration) string {
+	text, frac := formatDurationClock(v)
+	if us := frac / time.Microsecond; us > 0 {
+		text += strings.TrimRight(fmt.Sprintf(".%06d", us), "0")
+	}
+	return text
+}
+
+// formatDurationClock returns "[-]hh:mm:ss" string and the rest fraction of the second.
+func formatDurationClock(v time.Duration) (string, time.Duration) {
+	sign := ""
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	var (
+		h = v / time.Hour
+		m = (v % time.Hour) / time.Minute
+		s = (v % time.Minute) / time.Second
+	)
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, h, m, s), (v % time.Second)
+}
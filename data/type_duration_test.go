@@ -0,0 +1,51 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestDurationFormat(t *testing.T) {
+	const talk = time.Hour + 30*time.Minute + 250*time.Millisecond
+	for _, input := range []string{
+		"PT1H30M0.25S", "pt90m0,25s", "01:30:00.250", "1:30:00.25",
+		"1h30m0.25s", "01:30:00.25", "5400.25",
+	} {
+		rv := DurationAs(&datapb.Duration{}).New()
+		if err := rv.Decode(input); err != nil {
+			t.Errorf("Decode(%q) error = %v", input, err)
+			continue
+		}
+		if got := *rv.Interface().(*time.Duration); got != talk {
+			t.Errorf("Decode(%q) = %v, want %v", input, got, talk)
+		}
+	}
+	for _, input := range []string{"P", "PT", "P1H", "PT1", "1:3", "01:60", "-"} {
+		if _, err := ParseDurationISO8601(input); err == nil {
+			if _, err = ParseDurationClock(input); err == nil {
+				t.Errorf("Parse(%q) error = nil, want invalid", input)
+			}
+		}
+	}
+
+	for format, want := range map[string]any{
+		DurationSeconds:  5400.25,
+		DurationISO8601:  "PT1H30M0.25S",
+		DurationGo:       "1h30m0.25s",
+		DurationClock:    "01:30:00.250",
+		DurationInterval: "01:30:00.25",
+	} {
+		dt := DurationAs(&datapb.Duration{Format: format}).(*Duration)
+		if got := dt.MapValue(talk); got != want {
+			t.Errorf("Duration{%q}.MapValue() = %v, want %v", format, got, want)
+		}
+	}
+	if got := FormatDurationClock(-5 * time.Second); got != "-00:00:05" {
+		t.Errorf("FormatDurationClock(-5s) = %q", got)
+	}
+	if DurationAs(&datapb.Duration{Format: "hh:mm"}).Err() == nil {
+		t.Errorf("Duration{hh:mm}.Err() = nil, want format invalid")
+	}
+}
@@ -0,0 +1,266 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/webitel/custom/internal/pragma"
+	customrel "github.com/webitel/custom/reflect"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Enum (choice) data type
+type Enum struct {
+	spec *datapb.Enum
+	hash map[string]*datapb.Enum_Option // index[code]
+}
+
+// EnumAs primitive type
+func EnumAs(spec *datapb.Enum) Type {
+	dt := &Enum{spec: spec}
+	opts := spec.GetOptions()
+	dt.hash = make(map[string]*datapb.Enum_Option, len(opts))
+	for _, opt := range opts {
+		code := opt.GetCode()
+		if _, ok := dt.hash[code]; !ok {
+			dt.hash[code] = opt // first one wins
+		}
+	}
+	return dt
+}
+
+var _ Type = (*Enum)(nil)
+
+// Kind of the data type.
+func (*Enum) Kind() customrel.Kind {
+	return customrel.ENUM
+}
+
+// New data value codec.
+func (dt *Enum) New() customrel.Codec {
+	return &EnumValue{typof: dt}
+}
+
+// Err to check data type descriptor integrity.
+func (dt *Enum) Err() error {
+	opts := dt.Options()
+	if len(opts) == 0 {
+		return RequestError(
+			"custom.type.enum.options.required",
+			"custom: enum{options} required but missing",
+		)
+	}
+	for i, opt := range opts {
+		code := opt.GetCode()
+		if code == "" {
+			return RequestError(
+				"custom.type.enum.code.required",
+				"custom: enum{options[%d].code} required but missing",
+				i,
+			)
+		}
+		if dt.hash[code] != opt {
+			return RequestError(
+				"custom.type.enum.code.duplicate",
+				"custom: enum{options[%d].code} %q duplicate",
+				i, code,
+			)
+		}
+	}
+	return nil
+}
+
+func (*Enum) Custom(pragma.DoNotImplement) {}
+
+// Options of the enumeration, ordered.
+func (dt *Enum) Options() []*datapb.Enum_Option {
+	if dt != nil {
+		return dt.spec.GetOptions()
+	}
+	return nil
+}
+
+// Option by it's [code]. Returns nil if not found.
+func (dt *Enum) Option(code string) *datapb.Enum_Option {
+	if dt != nil {
+		return dt.hash[code]
+	}
+	return nil
+}
+
+// Title of the option [code] for the given [locale], e.g.: "uk", "en-US".
+// Falls back to the language base, e.g.: "en", then to the option name or code itself.
+func (dt *Enum) Title(code, locale string) string {
+	opt := dt.Option(code)
+	if opt == nil {
+		return code
+	}
+	title := opt.GetTitle()
+	for locale != "" {
+		if text := title[locale]; text != "" {
+			return text
+		}
+		i := strings.LastIndexAny(locale, "-_")
+		if i < 0 {
+			break
+		}
+		locale = locale[0:i]
+	}
+	if name := opt.GetName(); name != "" {
+		return name
+	}
+	return code
+}
+
+var enumViolations = map[string]string{
+	"not_found": "enum option {value} not found",
+}
+
+func (dt *Enum) violationError(kind string, val string) error {
+	return violationError("enum", kind, dt.spec.GetViolation(), enumViolations, violationArgs{
+		"value": strconv.Quote(val),
+	})
+}
+
+// Accept typical value type constraints
+func (dt *Enum) Accept(val *string) error {
+	if dt == nil {
+		// no constraints
+		return nil // [OK] ; whatever ..
+	}
+	if val == nil {
+		return nil // [OK] ; NULL
+	}
+	// [NOTE] deprecated option(s) are still valid
+	if dt.hash[*val] == nil {
+		return dt.violationError("not_found", *val)
+	}
+	return nil // [OK]
+}
+
+// EnumValue represents an option [code] value
+type EnumValue struct {
+	typof *Enum
+	value *string // NULL(-able)
+}
+
+var _ customrel.Codec = (*EnumValue)(nil)
+
+// Interface of the [*string] option code value.
+func (dv *EnumValue) Interface() any {
+	if dv != nil {
+		return dv.value
+	}
+	return (*string)(nil)
+}
+
+// Type of the data value
+func (dv *EnumValue) Type() customrel.Type {
+	if dv != nil {
+		return dv.typof
+	}
+	return (*Enum)(nil)
+}
+
+// Err to check underlying value
+// according to the data type constraints
+func (dv *EnumValue) Err() error {
+	if dv.IsNull() {
+		return nil
+	}
+	return dv.typof.Accept(dv.value)
+}
+
+// implements [Nullable] interface
+func (dv *EnumValue) IsNull() bool {
+	return dv == nil || dv.value == nil
+}
+
+func (dv *EnumValue) IsZero() bool {
+	return !dv.IsNull() && *dv.value == ""
+}
+
+// Option of the underlying value, if any.
+func (dv *EnumValue) Option() *datapb.Enum_Option {
+	if dv.IsNull() {
+		return nil
+	}
+	return dv.typof.Option(*dv.value)
+}
+
+func (dv *EnumValue) Decode(src any) error {
+	setValue := func(set *string) (err error) {
+		if set != nil && *set == "" {
+			set = nil // NULL ; no option
+		}
+		err = dv.typof.Accept(set)
+		if err == nil {
+			dv.value = set
+		}
+		return // err
+	}
+	// accept: src.(type)
+	if src == nil {
+		return setValue(nil)
+	}
+	switch data := src.(type) {
+	case EnumValue:
+		{
+			return setValue(data.value)
+		}
+	case *EnumValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			if data == dv {
+				return nil // SELF
+			}
+			return setValue(data.value)
+		}
+	case string:
+		{
+			return setValue(&data)
+		}
+	case *string:
+		{
+			return setValue(data)
+		}
+	case *structpb.Value:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			switch kind := data.Kind.(type) {
+			case nil, *structpb.Value_NullValue:
+				{
+					return setValue(nil) // NULL
+				}
+			case *structpb.Value_StringValue:
+				{
+					return setValue(&kind.StringValue)
+				}
+			}
+		}
+	case *wrapperspb.StringValue:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return setValue(&data.Value)
+		}
+	}
+	return fmt.Errorf(
+		"convert: %[1]T value %[1]v into Enum",
+		src,
+	)
+}
+
+func (dv *EnumValue) Encode(dst any) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (*EnumValue) Custom(pragma.DoNotImplement) {}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	customrel "github.com/webitel/custom/reflect"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestEnum(t *testing.T) {
+	status := EnumAs(&datapb.Enum{
+		Options: []*datapb.Enum_Option{
+			{Code: "new", Name: "New", Title: map[string]string{"uk": "Новий"}},
+			{Code: "done", Color: "#4caf50"},
+			{Code: "lost", Deprecated: true},
+		},
+	}).(*Enum)
+	if err := status.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+
+	tests := []struct {
+		input any
+		want  any // code ; or error id
+	}{
+		{"new", "new"},
+		{"lost", "lost"}, // deprecated ; still valid
+		{"", nil},
+		{nil, nil},
+		{"New", "custom.type.enum.not_found.violation"},
+	}
+	for _, tt := range tests {
+		rv := status.New()
+		err := rv.Decode(tt.input)
+		if err != nil {
+			var re *Error
+			if !errors.As(err, &re) || re.Id != tt.want {
+				t.Errorf("Decode(%v) error = %v, want %v", tt.input, err, tt.want)
+			}
+			continue
+		}
+		if got := customrel.Indirect(rv); got != tt.want {
+			t.Errorf("Decode(%v) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+
+	list := ListAs(status).New()
+	if err := list.Decode([]any{"new", "done"}); err != nil {
+		t.Errorf("List.Decode() error = %v", err)
+	}
+	if err := list.Decode([]any{"new", "gone"}); err == nil {
+		t.Errorf("List.Decode() error = nil, want not_found")
+	}
+
+	for locale, want := range map[string]string{
+		"uk": "Новий", "uk-UA": "Новий", "en": "New", "": "New",
+	} {
+		if got := status.Title("new", locale); got != want {
+			t.Errorf("Title(new, %q) = %q, want %q", locale, got, want)
+		}
+	}
+	if got := status.Title("done", "uk"); got != "done" {
+		t.Errorf("Title(done, uk) = %q, want done", got)
+	}
+
+	dup := EnumAs(&datapb.Enum{
+		Options: []*datapb.Enum_Option{{Code: "a"}, {Code: "a"}},
+	})
+	var re *Error
+	if err := dup.Err(); !errors.As(err, &re) || re.Id != "custom.type.enum.code.duplicate" {
+		t.Errorf("Err() = %v, want custom.type.enum.code.duplicate", err)
+	}
+}
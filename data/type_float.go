@@ -1,7 +1,513 @@
 package data
 
-import datapb "github.com/webitel/proto/gen/custom/data"
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
 
-func FloatAs(spec *datapb.Float) Type {
-	panic("not implemented")
+	"github.com/webitel/custom/internal/pragma"
+	customrel "github.com/webitel/custom/reflect"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Float [IEEE-754] number type
+type Float struct {
+	bits uint8         // [ 32, 64 ]
+	spec *datapb.Float // descriptor: constraints
+	min  float64
+	max  float64
+	err  error // .spec.(constraints) failed
+}
+
+// Float based types.
+var (
+	Float32 = Float{
+		bits: 32,
+		min:  -math.MaxFloat32,
+		max:  math.MaxFloat32,
+	}
+
+	Float64 = Float{
+		bits: 64,
+		min:  -math.MaxFloat64,
+		max:  math.MaxFloat64,
+	}
+)
+
+var (
+	// float base types
+	floatBits = map[uint8]*Float{
+		32: &Float32,
+		64: &Float64,
+	}
+)
+
+// floatAs number type.
+func floatAs(bitsize uint8, spec *datapb.Float) *Float {
+	number := &Float{
+		bits: bitsize,
+		spec: spec,
+	}
+	number.setup()
+	return number
+}
+
+// FloatAs number type.
+func FloatAs(bitsize uint8, spec *datapb.Float) Type {
+	return floatAs(bitsize, spec)
+}
+
+// setup float type value boundaries
+func (dt *Float) setup() (err error) {
+	defer func() {
+		dt.err = err
+	}()
+	base := floatBits[dt.bits]
+	if base == nil {
+		err = fmt.Errorf("float%d: invalid float type", dt.bits)
+		return
+	}
+	dt.min = base.min
+	dt.max = base.max
+	spec := dt.spec
+	if spec == nil {
+		return // nil
+	}
+	if frac := spec.GetFrac(); frac > 15 {
+		err = fmt.Errorf("float%d: invalid fractional part precision: %d; max: 15", base.bits, frac)
+		return // err
+	}
+	min := base.min
+	if set := spec.GetMin(); set != nil {
+		err = base.accept(" lower bound ", set.Value)
+		if err != nil {
+			return // err
+		}
+		min = set.Value
+	}
+	max := base.max
+	if set := spec.GetMax(); set != nil {
+		err = base.accept(" upper bound ", set.Value)
+		if err != nil {
+			return // err
+		}
+		max = set.Value
+	}
+	if max < min {
+		err = fmt.Errorf("float%d: invalid range of type values; min: %v; max: %v", base.bits, min, max)
+		return // err
+	}
+	// apply
+	dt.min = min
+	dt.max = max
+	return // nil // ok
+}
+
+// accept [arg]ument value [v] within base type boundaries
+func (dt *Float) accept(arg string, v float64) (err error) {
+	n := len(arg)
+	if n == 0 || arg[0] != ' ' {
+		arg = " " + arg
+		n++
+	}
+	if n > 1 && arg[n-1] != ' ' {
+		arg += " "
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		err = fmt.Errorf(
+			"float%d: invalid%svalue: %v",
+			dt.bits, arg, v,
+		)
+		return // err
+	}
+	if v < dt.min {
+		err = fmt.Errorf(
+			"float%d: invalid%svalue: %v; min: %v",
+			dt.bits, arg, v, dt.min,
+		)
+		return // err
+	}
+	if dt.max < v {
+		err = fmt.Errorf(
+			"float%d: invalid%svalue: %v; max: %v",
+			dt.bits, arg, v, dt.max,
+		)
+		return // err
+	}
+	return // nil
+}
+
+// As [dt] float base type with custom constraints.
+func (dt *Float) As(spec *datapb.Float) *Float {
+	if dt.spec == spec {
+		return dt // this
+	}
+	return floatAs(dt.bits, spec)
+}
+
+// Bits size of float type value.
+func (dt *Float) Bits() uint8 {
+	if dt != nil {
+		return dt.bits
+	}
+	return 0 // as 32
+}
+
+// Frac returns fractional part precision of the value.
+// Zero means no rounding is applied.
+func (dt *Float) Frac() uint32 {
+	if dt != nil {
+		return dt.spec.GetFrac()
+	}
+	return 0
+}
+
+// MinValue constraint of this float type.
+func (dt *Float) MinValue() float64 {
+	return dt.min
+}
+
+// MaxValue constraint of this float type.
+func (dt *Float) MaxValue() float64 {
+	return dt.max
+}
+
+var _ customrel.Type = (*Float)(nil)
+
+func (dt *Float) Kind() customrel.Kind {
+	if dt != nil {
+		switch dt.bits {
+		case 32:
+			return customrel.FLOAT32
+		case 64:
+			return customrel.FLOAT64
+		}
+	}
+	// default
+	return customrel.FLOAT
+}
+
+func (dt *Float) New() customrel.Codec {
+	return &FloatValue{
+		typof: dt,
+	}
+}
+
+func (dt *Float) Err() error {
+	if dt != nil {
+		return dt.err
+	}
+	return nil
+}
+
+func (*Float) Custom(pragma.DoNotImplement) {}
+
+var floatViolations = map[string]string{
+	"min": "float {value} violates min boundary of: {min}",
+	"max": "float {value} violates max boundary of: {max}",
+}
+
+func (dt *Float) violationError(kind string, val float64) error {
+	return violationError("float", kind, dt.spec.GetViolation(), floatViolations, violationArgs{
+		"value": strconv.FormatFloat(val, 'f', -1, 64),
+		"min":   strconv.FormatFloat(dt.min, 'f', -1, 64),
+		"max":   strconv.FormatFloat(dt.max, 'f', -1, 64),
+	})
+}
+
+// Round [v]alue to the fractional part precision of the type.
+func (dt *Float) Round(v float64) float64 {
+	frac := dt.Frac()
+	if frac == 0 {
+		return v // as is
+	}
+	exp := math.Pow10(int(frac))
+	if rv := math.Round(v*exp) / exp; !math.IsInf(rv, 0) {
+		return rv
+	}
+	// overflow: too big value to be rounded
+	return v
+}
+
+// Accept typical value type constraints
+func (dt *Float) Accept(val *float64) error {
+	if dt == nil {
+		// no constraints
+		return nil // [OK] ; whatever ..
+	}
+	if dt.err != nil {
+		// invalid type descriptor
+		return dt.err
+	}
+	if val == nil {
+		return nil // [OK] ; NULL
+	}
+	v := *val
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return RequestError(
+			"custom.type.float.value.invalid",
+			"custom: float value %v is not a number", v,
+		)
+	}
+	if v < dt.min {
+		return dt.violationError("min", v)
+	}
+	if dt.max < v {
+		return dt.violationError("max", v)
+	}
+	return nil // [OK]
+}
+
+// FloatValue represents a float number value
+type FloatValue struct {
+	typof *Float
+	value *float64 // NULL(-able)
+}
+
+var _ customrel.Codec = (*FloatValue)(nil)
+
+// Interface of the GoValue
+func (dv *FloatValue) Interface() any {
+	if dv != nil {
+		return dv.value
+	}
+	// typical: NULL
+	return (*float64)(nil)
+}
+
+func (dv *FloatValue) Type() customrel.Type {
+	if dv != nil {
+		return dv.typof
+	}
+	// default: FLOAT
+	return (*Float)(nil)
+}
+
+func (dv *FloatValue) Err() error {
+	if dv.IsNull() {
+		return nil
+	}
+	return dv.typof.Accept(dv.value)
+}
+
+// implements [Nullable] interface
+func (dv *FloatValue) IsNull() bool {
+	return dv == nil || dv.value == nil
 }
+
+func (dv *FloatValue) IsZero() bool {
+	return !dv.IsNull() && (*dv.value) == 0
+}
+
+func (dv *FloatValue) Decode(src any) error {
+	// accept: src.(type)
+	if src == nil {
+		dv.value = nil // NULL
+		return nil
+	}
+	// with .typeOf constraints
+	typeOf := dv.typof
+	setValue := func(val *float64) error {
+		if val == nil {
+			dv.value = nil
+			return nil // NULL
+		}
+		value := typeOf.Round(*val)
+		err := typeOf.Accept(&value)
+		if err != nil {
+			return err
+		}
+		dv.value = &value
+		return nil // OK
+	}
+	// float32 value as it's shortest decimal representation
+	// 0.1 => 0.10000000149011612 => 0.1
+	float32Value := func(val float32) error {
+		value, _ := strconv.ParseFloat(
+			strconv.FormatFloat(float64(val), 'g', -1, 32), 64,
+		)
+		return setValue(&value)
+	}
+	stringValue := func(src *string) error {
+		if src == nil {
+			return setValue(nil) // NULL
+		}
+		input := strings.TrimSpace(*src)
+		if input == "" {
+			return setValue(nil) // NULL
+		}
+		value, err := strconv.ParseFloat(input, 64)
+		if err != nil {
+			return fmt.Errorf("convert: string %q value into Float", input)
+		}
+		return setValue(&value)
+	}
+	switch input := src.(type) {
+	case FloatValue:
+		{
+			return setValue(input.value)
+		}
+	case *FloatValue:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			if input == dv {
+				return nil // SELF
+			}
+			return setValue(input.value)
+		}
+	case float64:
+		{
+			return setValue(&input)
+		}
+	case *float64:
+		{
+			return setValue(input)
+		}
+	case float32:
+		{
+			return float32Value(input)
+		}
+	case *float32:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			return float32Value(*input)
+		}
+	case string:
+		{
+			return stringValue(&input)
+		}
+	case *string:
+		{
+			return stringValue(input)
+		}
+	case *structpb.Value:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			switch kind := input.GetKind().(type) {
+			case nil:
+				{
+					return setValue(nil)
+				}
+			case *structpb.Value_NullValue:
+				{
+					return setValue(nil) // NULL
+				}
+			case *structpb.Value_NumberValue:
+				{
+					value := kind.NumberValue
+					return setValue(&value)
+				}
+			case *structpb.Value_StringValue:
+				{
+					return stringValue(&kind.StringValue)
+				}
+			// case *structpb.Value_BoolValue:
+			// case *structpb.Value_StructValue:
+			// case *structpb.Value_ListValue:
+			default:
+				{
+					ref := input.ProtoReflect()
+					def := ref.Descriptor()
+					fd := def.Fields().ByName("kind")
+					return fmt.Errorf(
+						"convert: %s value %v into Float", strings.TrimSuffix(string(
+							ref.WhichOneof(fd.ContainingOneof()).Name()),
+							"_value",
+						), ref.Get(fd).String(),
+					)
+				}
+			}
+		}
+	case *wrapperspb.DoubleValue:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			value := input.Value
+			return setValue(&value)
+		}
+	case *wrapperspb.FloatValue:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			return float32Value(input.Value)
+		}
+	case *wrapperspb.Int64Value:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			value := float64(input.Value)
+			return setValue(&value)
+		}
+	case *wrapperspb.UInt64Value:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			value := float64(input.Value)
+			return setValue(&value)
+		}
+	case *wrapperspb.Int32Value:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			value := float64(input.Value)
+			return setValue(&value)
+		}
+	case *wrapperspb.UInt32Value:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			value := float64(input.Value)
+			return setValue(&value)
+		}
+	case *wrapperspb.StringValue:
+		{
+			if input == nil {
+				return setValue(nil)
+			}
+			return stringValue(&input.Value)
+		}
+	}
+	// [u]int[8|16|32|64] -or- *[u]int[8|16|32|64]
+	rv := reflect.ValueOf(src)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return setValue(nil)
+		}
+		rv = rv.Elem()
+	}
+	var value float64
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value = float64(rv.Uint())
+	default:
+		return fmt.Errorf(
+			"convert: %[1]T value %[1]v into Float",
+			src,
+		)
+	}
+	return setValue(&value)
+}
+
+func (dv *FloatValue) Encode(dst any) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (*FloatValue) Custom(pragma.DoNotImplement) {}
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"testing"
+
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestFloatValueDecode(t *testing.T) {
+	price := Float64.As(&datapb.Float{
+		Frac: 2,
+		Min:  wrapperspb.Double(0),
+		Max:  wrapperspb.Double(1000),
+	})
+
+	tests := []struct {
+		name     string
+		typeOf   *Float
+		input    any
+		expected *float64
+		invalid  bool
+	}{
+		{name: "null", typeOf: &Float64, input: nil, expected: nil},
+		{name: "float64", typeOf: &Float64, input: 1.5, expected: ptr(1.5)},
+		{name: "float32", typeOf: &Float32, input: float32(0.1), expected: ptr(0.1)},
+		{name: "int", typeOf: &Float64, input: 42, expected: ptr(42.0)},
+		{name: "*uint8", typeOf: &Float64, input: ptr(uint8(7)), expected: ptr(7.0)},
+		{name: "string", typeOf: &Float64, input: " 3.25 ", expected: ptr(3.25)},
+		{name: "empty string", typeOf: &Float64, input: "", expected: nil},
+		{name: "bad string", typeOf: &Float64, input: "3,25", invalid: true},
+		{name: "structpb", typeOf: &Float64, input: structpb.NewNumberValue(2.5), expected: ptr(2.5)},
+		{name: "wrapper", typeOf: &Float64, input: wrapperspb.Int32(-3), expected: ptr(-3.0)},
+		{name: "frac round", typeOf: price, input: 9.996, expected: ptr(10.0)},
+		{name: "frac round down", typeOf: price, input: "19.9949", expected: ptr(19.99)},
+		{name: "min violation", typeOf: price, input: -0.01, invalid: true},
+		{name: "max violation", typeOf: price, input: 1000.01, invalid: true},
+		{name: "float32 overflow", typeOf: &Float32, input: 1e39, invalid: true},
+		{name: "bool", typeOf: &Float64, input: true, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rv := tt.typeOf.New()
+			err := rv.Decode(tt.input)
+			if tt.invalid {
+				if err == nil {
+					t.Errorf("Decode(%v) = %v, want error", tt.input, rv.Interface())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode(%v) error: %v", tt.input, err)
+			}
+			value := rv.Interface().(*float64)
+			switch {
+			case value == nil && tt.expected == nil:
+			case value == nil || tt.expected == nil || *value != *tt.expected:
+				t.Errorf("Decode(%v) = %v, want %v", tt.input, deref(value), deref(tt.expected))
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func deref[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
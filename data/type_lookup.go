@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,7 @@ import (
 type Lookup struct {
 	spec *datapb.Lookup
 	rel  customrel.DictionaryDescriptor
+	res  LookupResolver // existence ; optional
 	err  error
 }
 
@@ -72,6 +74,18 @@ func LookupAs(
 			return ref
 		}
 	}
+	// [CHECK] query filter(s) of the known field(s)
+	fields := ref.rel.Fields()
+	for _, name := range ref.queryFields() {
+		if fields.ByName(name) == nil {
+			ref.err = RequestError(
+				"custom.type.lookup.query.field.not_found",
+				"custom: lookup( path: %s ).query( %s ); no such field",
+				spec.Path, name,
+			)
+			break
+		}
+	}
 	return ref
 }
 
@@ -85,6 +99,89 @@ func (dt *Lookup) Dictionary() customrel.DictionaryDescriptor {
 
 var _ customrel.Type = (*Lookup)(nil)
 
+// Query filter(s) of the referenced dictionary record(s).
+// map[field]template ; the template MAY refer to the other field(s)
+// of the same record, e.g.: { "team": "{team}", "state": "active" }.
+func (dt *Lookup) Query() map[string]string {
+	if dt != nil {
+		return dt.spec.GetQuery()
+	}
+	return nil
+}
+
+// queryFields returns the sorted names of the Query filter field(s).
+func (dt *Lookup) queryFields() []string {
+	query := dt.Query()
+	if len(query) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// QueryOf returns the Query filter(s) evaluated with the [rec]ord field value(s).
+// map[field]value ; the value is nil if the template refers to the NULL field value only.
+func (dt *Lookup) QueryOf(rec *Record) map[string]any {
+	query := dt.Query()
+	if len(query) == 0 {
+		return nil
+	}
+	filter := make(map[string]any, len(query))
+	for name, tmpl := range query {
+		var (
+			text  strings.Builder
+			parts int
+			null  bool
+		)
+		RangeTemplate(tmpl, func(part string, param bool) bool {
+			parts++
+			if !param {
+				text.WriteString(part)
+				return true
+			}
+			vs, ok := templateValue(rec, part)
+			if !ok {
+				null = true
+			}
+			text.WriteString(vs)
+			return true
+		})
+		if null && parts == 1 {
+			filter[name] = nil // NULL
+			continue
+		}
+		filter[name] = text.String()
+	}
+	return filter
+}
+
+// templateValue returns the [rec]ord field [path] value as a template argument.
+// The [ok] indicates whether the value is NOT NULL.
+func templateValue(rec *Record, path string) (vs string, ok bool) {
+	if rec == nil {
+		return "", false
+	}
+	v, err := rec.GetPath(path)
+	if err != nil {
+		return "", false
+	}
+	if ref, is := v.(*custompb.Lookup); is {
+		return ref.GetId(), ref.GetId() != ""
+	}
+	switch v := mapValue(v).(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
 // Kind of basic type
 // Type() Type // indirect type for: list, lookup
 func (*Lookup) Kind() customrel.Kind {
@@ -111,6 +208,20 @@ func (dt *Lookup) Err() error {
 // pragma.DoNotImplement
 func (*Lookup) Custom(pragma.DoNotImplement) {}
 
+var lookupViolations = map[string]string{
+	"not_found":        "lookup {value} not found in {path}",
+	"too_much_records": "lookup {value} matches too much {path} records",
+}
+
+// ViolationError of the reference [value] constraint [rule], e.g.: "not_found", "too_much_records".
+// The value resolution is up to the storage, so the rule is checked outside of the data type.
+func (dt *Lookup) ViolationError(rule, value string) error {
+	return violationError("lookup", rule, dt.spec.GetViolation(), lookupViolations, violationArgs{
+		"value": strconv.Quote(value),
+		"path":  dt.spec.GetPath(),
+	})
+}
+
 // reference as a Lookup compatible Value.
 type reference interface {
 	// [Required] GetId value of the field, marked it's type structure as `primary`.
@@ -0,0 +1,199 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/webitel/custom/internal/pragma"
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Struct data type of the nested [RECORD] structure.
+type Struct struct {
+	spec *custompb.Struct
+	typo *dataset // nested structure
+}
+
+// StructAs nested structure type of the [name] field within [of] dataset.
+func StructAs(of customrel.DatasetDescriptor, name string, spec *custompb.Struct) Type {
+	var (
+		dc  int64
+		pkg = name
+	)
+	if of != nil {
+		dc = of.Dc()
+		pkg = path.Join(of.Path(), name)
+	}
+	return &Struct{
+		spec: spec,
+		typo: datasetAs(dc, &custompb.Dataset{
+			Repo:   name,
+			Path:   pkg,
+			Fields: spec.GetFields(),
+		}),
+	}
+}
+
+var _ Type = (*Struct)(nil)
+
+// Kind of the data type.
+func (*Struct) Kind() customrel.Kind {
+	return customrel.RECORD
+}
+
+// Fields of the nested structure.
+func (dt *Struct) Fields() customrel.FieldDescriptors {
+	return dt.typo.Fields()
+}
+
+// Dataset of the nested structure.
+// [NOTE] It has neither [primary] nor [display] field.
+func (dt *Struct) Dataset() customrel.DatasetDescriptor {
+	return dt.typo
+}
+
+// New data value codec.
+func (dt *Struct) New() customrel.Codec {
+	return &StructValue{typof: dt}
+}
+
+// Err to check data type descriptor integrity.
+func (dt *Struct) Err() error {
+	if dt == nil || dt.typo == nil {
+		return fmt.Errorf("custom: record{fields} undefined")
+	}
+	return fieldsViolations(dt.typo.dc, dt.Fields(), "fields").Err()
+}
+
+func (*Struct) Custom(pragma.DoNotImplement) {}
+
+// ---------------------------------------- //
+//         Nested Record of Value(s)        //
+// ---------------------------------------- //
+
+// StructValue of the nested [RECORD] structure.
+type StructValue struct {
+	typof *Struct
+	value *Record
+}
+
+var _ customrel.Codec = (*StructValue)(nil)
+
+// Interface of the underlying value.
+func (dv *StructValue) Interface() any {
+	if dv != nil {
+		return dv.value
+	}
+	return (*Record)(nil)
+}
+
+// Type of the data value
+func (dv *StructValue) Type() customrel.Type {
+	if dv != nil {
+		return dv.typof
+	}
+	return (*Struct)(nil)
+}
+
+// Err to check underlying value
+// according to the data type constraints
+func (dv *StructValue) Err() error {
+	if dv.IsNull() {
+		return nil
+	}
+	return dv.value.Validate(true)
+}
+
+func (dv *StructValue) IsNull() bool {
+	return dv == nil || dv.value == nil
+}
+
+func (dv *StructValue) IsZero() bool {
+	return !dv.IsNull() && len(dv.value.Fields()) == 0
+}
+
+// Decode nested record from [src] value, e.g.:
+// *Record, map[string]any, *structpb.Struct, JSON object string.
+func (dv *StructValue) Decode(src any) error {
+	setValue := func(data map[string]any) error {
+		if data == nil {
+			dv.value = nil // NULL
+			return nil
+		}
+		rec := NewRecord(dv.typof.typo)
+		obj, err := structpb.NewStruct(data)
+		if err != nil {
+			return fmt.Errorf("convert %T into record; %v", src, err)
+		}
+		err = rec.FromProto(obj)
+		if err != nil {
+			return err
+		}
+		dv.value = rec
+		return nil
+	}
+	jsonValue := func(data []byte) error {
+		if len(data) == 0 || string(data) == "null" {
+			return setValue(nil)
+		}
+		var obj map[string]any
+		err := json.Unmarshal(data, &obj)
+		if err != nil {
+			return fmt.Errorf("convert %T into record; %v", src, err)
+		}
+		return setValue(obj)
+	}
+	switch data := src.(type) {
+	case nil:
+		dv.value = nil // NULL
+	case *Record:
+		{
+			if data == nil {
+				dv.value = nil // NULL
+				return nil
+			}
+			if data.Dataset() == dv.typof.Dataset() {
+				dv.value = data // same type !
+				return nil
+			}
+			return setValue(data.AsMap())
+		}
+	case map[string]any:
+		return setValue(data)
+	case *structpb.Struct:
+		{
+			if data == nil {
+				return setValue(nil)
+			}
+			return setValue(data.AsMap())
+		}
+	case *structpb.Value:
+		{
+			switch v := data.GetKind().(type) {
+			case nil, *structpb.Value_NullValue:
+				return setValue(nil)
+			case *structpb.Value_StructValue:
+				return setValue(v.StructValue.AsMap())
+			case *structpb.Value_StringValue:
+				return jsonValue([]byte(v.StringValue))
+			}
+			return fmt.Errorf("convert %T into record", src)
+		}
+	case string:
+		return jsonValue([]byte(data))
+	case []byte:
+		return jsonValue(data)
+	default:
+		return fmt.Errorf("convert %T into record", src)
+	}
+	return nil
+}
+
+func (dv *StructValue) Encode(dst any) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (*StructValue) Custom(pragma.DoNotImplement) {}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestRecordNested(t *testing.T) {
+	contacts, err := NewDictionary(1, &custompb.InputDictionary{
+		Name: "contacts",
+		Fields: []*custompb.Field{
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "address", Kind: datapb.Kind_record, Type: &custompb.Field_Record{
+				Record: &custompb.Struct{Fields: []*custompb.Field{
+					{Id: "city", Kind: datapb.Kind_string},
+					{Id: "zip", Kind: datapb.Kind_string, Required: true},
+					{Id: "code", Kind: datapb.Kind_string, Readonly: true},
+				}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewDictionary() error = %v", err)
+	}
+
+	rec := NewRecord(contacts)
+	if err = rec.SetPath("address.city", "Kyiv"); err != nil {
+		t.Fatalf("SetPath(address.city) error = %v", err)
+	}
+	if got, _ := rec.GetPath("address.city"); customrel.Indirect(got) != "Kyiv" {
+		t.Errorf("GetPath(address.city) = %v, want Kyiv", got)
+	}
+	if got, _ := rec.GetPath("address.zip"); customrel.Indirect(got) != nil {
+		t.Errorf("GetPath(address.zip) = %v, want <nil>", got)
+	}
+	if err = rec.SetPath("address.country", "UA"); err == nil {
+		t.Errorf("SetPath(address.country) error = nil, want not found")
+	}
+	// nested [required] constraint
+	hasRule := func(err error, field, rule string) bool {
+		var errs Violations
+		errors.As(err, &errs)
+		for _, v := range errs {
+			if v.Field == field && v.Rule == rule {
+				return true
+			}
+		}
+		return false
+	}
+	if err = rec.Validate(false); !hasRule(err, "address.zip", "custom.field.required.violation") {
+		t.Errorf("Validate() error = %v, want address.zip required", err)
+	}
+	_ = rec.SetPath("address.zip", "01001")
+	if err = rec.Validate(true); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	// round-trip
+	dup := NewRecord(contacts)
+	if err = dup.FromProto(rec.Proto()); err != nil {
+		t.Fatalf("FromProto() error = %v", err)
+	}
+	if got, _ := dup.GetPath("address.zip"); customrel.Indirect(got) != "01001" {
+		t.Errorf("FromProto(): address.zip = %v, want 01001", got)
+	}
+
+	// nested [readonly] constraint ; of the [enduser] input
+	user := NewRecord(contacts)
+	user.BeginUpdate(WriteUser)
+	err = user.SetPath("address.code", "UA-30")
+	var re *Error
+	if !errors.As(err, &re) || re.Id != "custom.field.readonly.violation" {
+		t.Errorf("SetPath(address.code) error = %v, want custom.field.readonly.violation", err)
+	}
+	if err = user.SetPath("address.city", "Lviv"); err != nil {
+		t.Errorf("SetPath(address.city) error = %v", err)
+	}
+	_ = user.EndUpdate(true)
+	if err = user.SetPath("address.code", "UA-46"); err != nil {
+		t.Errorf("SetPath(address.code) error = %v ; WriteSystem mode", err)
+	}
+}
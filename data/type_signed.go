@@ -216,6 +216,41 @@ func (dt *Signed) Err() error {
 
 func (*Signed) Custom(pragma.DoNotImplement) {}
 
+var intViolations = map[string]string{
+	"min": "int {value} violates min boundary of: {min}",
+	"max": "int {value} violates max boundary of: {max}",
+}
+
+func (dt *Signed) violationError(kind string, val int64) error {
+	return violationError("int", kind, dt.spec.GetViolation(), intViolations, violationArgs{
+		"value": strconv.FormatInt(val, 10),
+		"min":   strconv.FormatInt(dt.min, 10),
+		"max":   strconv.FormatInt(dt.max, 10),
+	})
+}
+
+// Accept typical value type constraints
+func (dt *Signed) Accept(val *int64) error {
+	if dt == nil {
+		// no constraints
+		return nil // [OK] ; whatever ..
+	}
+	if dt.err != nil {
+		// invalid type descriptor
+		return dt.err
+	}
+	if val == nil {
+		return nil // [OK] ; NULL
+	}
+	if v := *val; v < dt.min {
+		return dt.violationError("min", v)
+	}
+	if v := *val; dt.max < v {
+		return dt.violationError("max", v)
+	}
+	return nil // [OK]
+}
+
 // SignedValue represents an integer value
 type SignedValue struct {
 	typof *Signed
@@ -245,7 +280,7 @@ func (dv *SignedValue) Err() error {
 	if dv.IsNull() {
 		return nil
 	}
-	return dv.typof.accept(" ", *dv.value)
+	return dv.typof.Accept(dv.value)
 }
 
 // implements [Nullable] interface
@@ -270,7 +305,7 @@ func (dv *SignedValue) Decode(src any) error {
 			dv.value = nil
 			return nil // NULL
 		}
-		err := typeOf.accept(" ", int64(*val))
+		err := typeOf.Accept(val)
 		if err != nil {
 			return err
 		}
@@ -370,7 +405,7 @@ func (dv *SignedValue) Decode(src any) error {
 		}
 	case int64:
 		{
-			err := typeOf.accept(" ", input)
+			err := typeOf.Accept(&input)
 			if err != nil {
 				return err
 			}
@@ -382,7 +417,7 @@ func (dv *SignedValue) Decode(src any) error {
 			if input == nil {
 				return setValue(nil)
 			}
-			err := typeOf.accept(" ", *input)
+			err := typeOf.Accept(input)
 			if err != nil {
 				return err
 			}
@@ -2,9 +2,14 @@ package data
 
 import (
 	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/webitel/custom/internal/pragma"
 	customrel "github.com/webitel/custom/reflect"
 	datapb "github.com/webitel/proto/gen/custom/data"
@@ -14,12 +19,66 @@ import (
 
 type String struct {
 	spec *datapb.Text
+	expr *regexp.Regexp // compiled [accept_regex]
 	err  error
 }
 
 // StringAs primtive type
 func StringAs(spec *datapb.Text) Type {
-	return &String{spec: spec}
+	dt := &String{spec: spec}
+	dt.setup()
+	return dt
+}
+
+func (dt *String) setup() {
+	if pattern := dt.spec.GetAcceptRegex(); pattern != "" {
+		dt.expr, dt.err = compileRegexp(pattern)
+		if dt.err != nil {
+			dt.err = RequestError(
+				"custom.type.string.accept_regex.invalid",
+				"custom: string{accept_regex} %q is invalid ; %v",
+				pattern, dt.err,
+			)
+			return
+		}
+	}
+	if preset := dt.spec.GetPreset(); datapb.Text_Preset_name[int32(preset)] == "" {
+		dt.err = RequestError(
+			"custom.type.string.preset.invalid",
+			"custom: string{preset} %d is not supported",
+			preset,
+		)
+	}
+}
+
+// compiled regular expression(s) cache ; shared by the types of all domains
+var regexpCache, _ = lru.New[string, *regexp.Regexp](512)
+
+// compileRegexp returns the [pattern] compiled, cached.
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if expr, ok := regexpCache.Get(pattern); ok {
+		return expr, nil
+	}
+	expr, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexpCache.Add(pattern, expr)
+	return expr, nil
+}
+
+// IgnoreCase reports whether the value(s) equality is case-insensitive.
+func (dt *String) IgnoreCase() bool {
+	return dt != nil && dt.spec.GetIgnoreCase()
+}
+
+// Equal reports whether [a] and [b] value(s) are equal
+// according to the [IgnoreCase] equality strategy.
+func (dt *String) Equal(a, b string) bool {
+	if dt.IgnoreCase() {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
 }
 
 var _ Type = (*String)(nil)
@@ -45,9 +104,14 @@ func (dt *String) Err() error {
 func (*String) Custom(pragma.DoNotImplement) {}
 
 var stringViolations = map[string]string{
-	"multiline": "string {{.value}} violates multiline constraint",
-	"max_bytes": "string {{.value}} violates max boundary of: {{.max_bytes}} bytes",
-	"max_chars": "string {{.value}} violates max boundary of: {{.max_chars}} characters",
+	"multiline":    "string {value} violates multiline constraint",
+	"max_bytes":    "string {value} violates max boundary of: {max_bytes} bytes",
+	"max_chars":    "string {value} violates max boundary of: {max_chars} characters",
+	"accept_regex": "string {value} does not match the {accept_regex} format",
+	"email":        "string {value} is not a valid e-mail address",
+	"phone":        "string {value} is not a valid E.164 phone number",
+	"url":          "string {value} is not a valid absolute URL",
+	"slug":         "string {value} is not a valid slug",
 }
 
 func (dt *String) violationError(kind string, val *string) error {
@@ -55,13 +119,40 @@ func (dt *String) violationError(kind string, val *string) error {
 	if num := utf8.RuneCountInString(text); num > 16 {
 		text = fmt.Sprintf("%s..(+%d)", []byte(text)[0:14], (num - 16))
 	}
-	tmpl := stringViolations[kind]
-	tmpl = strings.ReplaceAll(tmpl, "{{.value}}", fmt.Sprintf("%q", text))
-	tmpl = strings.ReplaceAll(tmpl, "{{.max_bytes}}", fmt.Sprintf("%d", dt.spec.GetMaxBytes()))
-	tmpl = strings.ReplaceAll(tmpl, "{{.max_chars}}", fmt.Sprintf("%d", dt.spec.GetMaxChars()))
-	return RequestError(
-		fmt.Sprintf("custom.type.string.%s.violation", kind), ("custom: " + tmpl),
-	)
+	max := dt.spec.GetMaxChars()
+	if kind == "max_bytes" {
+		max = dt.spec.GetMaxBytes()
+	}
+	return violationError("string", kind, dt.spec.GetViolation(), stringViolations, violationArgs{
+		"value":        strconv.Quote(text),
+		"max":          strconv.FormatUint(uint64(max), 10),
+		"max_bytes":    strconv.FormatUint(uint64(dt.spec.GetMaxBytes()), 10),
+		"max_chars":    strconv.FormatUint(uint64(dt.spec.GetMaxChars()), 10),
+		"accept_regex": strconv.Quote(dt.spec.GetAcceptRegex()),
+	})
+}
+
+var (
+	// E.164 ; + country code and subscriber number, up to 15 digits
+	phoneRegexp = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	// lower-case alphanumeric word(s), separated by single hyphen
+	slugRegexp = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+)
+
+// stringPresets value format checks
+var stringPresets = map[datapb.Text_Preset]func(string) bool{
+	datapb.Text_email: func(v string) bool {
+		addr, err := mail.ParseAddress(v)
+		// [NOTE] bare address ONLY ; no display name
+		return err == nil && addr.Address == v
+	},
+	datapb.Text_phone: phoneRegexp.MatchString,
+	datapb.Text_url: func(v string) bool {
+		link, err := url.Parse(v)
+		return err == nil && link.Scheme != "" && link.Host != "" &&
+			!strings.ContainsAny(v, " \t\n\r")
+	},
+	datapb.Text_slug: slugRegexp.MatchString,
 }
 
 // Accept typical value type constraints
@@ -96,6 +187,12 @@ func (dt *String) Accept(val *string) error {
 	if max := dt.spec.MaxChars; 0 < max && int(max) < utf8.RuneCountInString(*val) {
 		return dt.violationError("max_chars", val)
 	}
+	if preset := dt.spec.Preset; preset != datapb.Text_none && !stringPresets[preset](*val) {
+		return dt.violationError(preset.String(), val)
+	}
+	if dt.expr != nil && !dt.expr.MatchString(*val) {
+		return dt.violationError("accept_regex", val)
+	}
 	return nil // OK
 }
 
@@ -282,8 +379,6 @@ func (dv *StringValue) Decode(src any) error {
 			)
 		}
 	}
-	panic("unreachable code")
-	return nil // OK
 }
 
 func (dv *StringValue) Encode(dst any) error {
@@ -302,8 +397,10 @@ func (dv *StringValue) Type() customrel.Type {
 //
 //	according to the data type constraints
 func (dv *StringValue) Err() error {
-	// TODO: [re]design Codec interface method
-	return nil
+	if dv == nil || dv.value == nil {
+		return nil // NULL
+	}
+	return dv.typof.Accept(dv.value)
 }
 
 func (*StringValue) Custom(pragma.DoNotImplement) {}
@@ -0,0 +1,47 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestStringAccept(t *testing.T) {
+	tests := []struct {
+		spec  *datapb.Text
+		input string
+		want  string // violation id ; empty if valid
+	}{
+		{&datapb.Text{AcceptRegex: `^[A-Z]{2}[0-9]{6}$`}, "AB123456", ""},
+		{&datapb.Text{AcceptRegex: `^[A-Z]{2}[0-9]{6}$`}, "ab123456", "custom.type.string.accept_regex.violation"},
+		{&datapb.Text{Preset: datapb.Text_email}, "user@example.com", ""},
+		{&datapb.Text{Preset: datapb.Text_email}, "User <user@example.com>", "custom.type.string.email.violation"},
+		{&datapb.Text{Preset: datapb.Text_phone}, "+380441234567", ""},
+		{&datapb.Text{Preset: datapb.Text_phone}, "0441234567", "custom.type.string.phone.violation"},
+		{&datapb.Text{Preset: datapb.Text_url}, "https://example.com/path?q=1", ""},
+		{&datapb.Text{Preset: datapb.Text_url}, "/relative/path", "custom.type.string.url.violation"},
+		{&datapb.Text{Preset: datapb.Text_slug}, "lower-case-words-123", ""},
+		{&datapb.Text{Preset: datapb.Text_slug}, "Not--Slug", "custom.type.string.slug.violation"},
+		{&datapb.Text{AcceptRegex: `[`}, "any", "custom.type.string.accept_regex.invalid"},
+		{&datapb.Text{Preset: 99}, "any", "custom.type.string.preset.invalid"},
+	}
+	for _, tt := range tests {
+		var (
+			re  *Error
+			got string
+			err = StringAs(tt.spec).New().Decode(tt.input)
+		)
+		if errors.As(err, &re) {
+			got = re.Id
+		}
+		if got != tt.want {
+			t.Errorf("String{%v}.Decode(%q) error = %v, want %s", tt.spec, tt.input, err, tt.want)
+		}
+	}
+
+	email := StringAs(&datapb.Text{IgnoreCase: true}).(*String)
+	if !email.Equal("User@Example.com", "user@example.COM") {
+		t.Errorf("String{ignore_case}.Equal() = false, want true")
+	}
+}
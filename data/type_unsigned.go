@@ -214,6 +214,41 @@ func (dt *Unsigned) Err() error {
 
 func (*Unsigned) Custom(pragma.DoNotImplement) {}
 
+var uintViolations = map[string]string{
+	"min": "uint {value} violates min boundary of: {min}",
+	"max": "uint {value} violates max boundary of: {max}",
+}
+
+func (dt *Unsigned) violationError(kind string, val uint64) error {
+	return violationError("uint", kind, dt.spec.GetViolation(), uintViolations, violationArgs{
+		"value": strconv.FormatUint(val, 10),
+		"min":   strconv.FormatUint(dt.min, 10),
+		"max":   strconv.FormatUint(dt.max, 10),
+	})
+}
+
+// Accept typical value type constraints
+func (dt *Unsigned) Accept(val *uint64) error {
+	if dt == nil {
+		// no constraints
+		return nil // [OK] ; whatever ..
+	}
+	if dt.err != nil {
+		// invalid type descriptor
+		return dt.err
+	}
+	if val == nil {
+		return nil // [OK] ; NULL
+	}
+	if v := *val; v < dt.min {
+		return dt.violationError("min", v)
+	}
+	if v := *val; dt.max < v {
+		return dt.violationError("max", v)
+	}
+	return nil // [OK]
+}
+
 // SignedValue represents an integer value
 type UnsignedValue struct {
 	typof *Unsigned
@@ -243,7 +278,7 @@ func (dv *UnsignedValue) Err() error {
 	if dv.IsNull() {
 		return nil
 	}
-	return dv.typof.accept(" ", *dv.value)
+	return dv.typof.Accept(dv.value)
 }
 
 // implements [Nullable] interface
@@ -268,7 +303,7 @@ func (dv *UnsignedValue) Decode(src any) error {
 			dv.value = nil
 			return nil // NULL
 		}
-		err := typeOf.accept(" ", uint64(*val))
+		err := typeOf.Accept(val)
 		if err != nil {
 			return err
 		}
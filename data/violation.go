@@ -0,0 +1,64 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Violation of the dataset structure integrity.
+type Violation struct {
+	// Path to the specification element violated.
+	// e.g.: "name", "fields[2].type", "indices.code.fields[0]"
+	Field string
+	// Rule identifier been violated.
+	// e.g.: "custom.dataset.primary.not_found"
+	Rule string
+	// Message of the violation details.
+	Message string
+	// cause of the field value violation, if any
+	cause *Error
+}
+
+// newViolation of the [rule] for the [field] spec element.
+func newViolation(field, rule, format string, args ...any) *Violation {
+	return &Violation{
+		Field:   field,
+		Rule:    rule,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *Violation) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Violations of the dataset structure integrity.
+type Violations []*Violation
+
+func (e Violations) Error() string {
+	text := make([]string, len(e))
+	for i, v := range e {
+		text[i] = v.Error()
+	}
+	return strings.Join(text, "; ")
+}
+
+// Unwrap returns the list of violations as errors.
+func (e Violations) Unwrap() []error {
+	list := make([]error, len(e))
+	for i, v := range e {
+		list[i] = v
+	}
+	return list
+}
+
+// Err returns the [e] violations as an error, if any.
+func (e Violations) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
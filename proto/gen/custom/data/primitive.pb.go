@@ -43,6 +43,10 @@ const (
 	Kind_richtext Kind = 15
 	Kind_datetime Kind = 16 // date &| time
 	Kind_duration Kind = 17
+	Kind_record   Kind = 18 // nested structure
+	Kind_enum     Kind = 19 // choice of option(s)
+	Kind_decimal  Kind = 20 // fixed-point number
+	Kind_money    Kind = 21 // decimal amount of currency
 )
 
 // Enum value maps for Kind.
@@ -66,6 +70,10 @@ var (
 		15: "richtext",
 		16: "datetime",
 		17: "duration",
+		18: "record",
+		19: "enum",
+		20: "decimal",
+		21: "money",
 	}
 	Kind_value = map[string]int32{
 		"none":     0,
@@ -86,6 +94,10 @@ var (
 		"richtext": 15,
 		"datetime": 16,
 		"duration": 17,
+		"record":   18,
+		"enum":     19,
+		"decimal":  20,
+		"money":    21,
 	}
 )
 
@@ -116,6 +128,171 @@ func (Kind) EnumDescriptor() ([]byte, []int) {
 	return file_custom_data_primitive_proto_rawDescGZIP(), []int{0}
 }
 
+// Format preset(s), built-in.
+type Text_Preset int32
+
+const (
+	Text_none  Text_Preset = 0
+	Text_email Text_Preset = 1 // e-mail address ; user@example.com
+	Text_phone Text_Preset = 2 // E.164 phone number ; +380441234567
+	Text_url   Text_Preset = 3 // absolute URL ; https://example.com/path
+	Text_slug  Text_Preset = 4 // URL slug ; lower-case-words-123
+)
+
+// Enum value maps for Text_Preset.
+var (
+	Text_Preset_name = map[int32]string{
+		0: "none",
+		1: "email",
+		2: "phone",
+		3: "url",
+		4: "slug",
+	}
+	Text_Preset_value = map[string]int32{
+		"none":  0,
+		"email": 1,
+		"phone": 2,
+		"url":   3,
+		"slug":  4,
+	}
+)
+
+func (x Text_Preset) Enum() *Text_Preset {
+	p := new(Text_Preset)
+	*p = x
+	return p
+}
+
+func (x Text_Preset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Text_Preset) Descriptor() protoreflect.EnumDescriptor {
+	return file_custom_data_primitive_proto_enumTypes[1].Descriptor()
+}
+
+func (Text_Preset) Type() protoreflect.EnumType {
+	return &file_custom_data_primitive_proto_enumTypes[1]
+}
+
+func (x Text_Preset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Text_Preset.Descriptor instead.
+func (Text_Preset) EnumDescriptor() ([]byte, []int) {
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// Part of the datetime value.
+type Datetime_Part int32
+
+const (
+	Datetime_full Datetime_Part = 0 // date & time
+	Datetime_date Datetime_Part = 1 // date only ; YYYY-MM-DD
+	Datetime_time Datetime_Part = 2 // time only ; HH:mm:ss[.pres]
+)
+
+// Enum value maps for Datetime_Part.
+var (
+	Datetime_Part_name = map[int32]string{
+		0: "full",
+		1: "date",
+		2: "time",
+	}
+	Datetime_Part_value = map[string]int32{
+		"full": 0,
+		"date": 1,
+		"time": 2,
+	}
+)
+
+func (x Datetime_Part) Enum() *Datetime_Part {
+	p := new(Datetime_Part)
+	*p = x
+	return p
+}
+
+func (x Datetime_Part) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Datetime_Part) Descriptor() protoreflect.EnumDescriptor {
+	return file_custom_data_primitive_proto_enumTypes[2].Descriptor()
+}
+
+func (Datetime_Part) Type() protoreflect.EnumType {
+	return &file_custom_data_primitive_proto_enumTypes[2]
+}
+
+func (x Datetime_Part) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Datetime_Part.Descriptor instead.
+func (Datetime_Part) EnumDescriptor() ([]byte, []int) {
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{9, 0}
+}
+
+// Time precision.
+type Datetime_Stamp int32
+
+const (
+	Datetime_ms Datetime_Stamp = 0  // [milli]seconds ; E+3 ; default
+	Datetime_s  Datetime_Stamp = 1  // seconds
+	Datetime_mc Datetime_Stamp = 2  // [micro]seconds ; E+6
+	Datetime_ns Datetime_Stamp = 3  // [nano]seconds  ; E+9
+	Datetime_m  Datetime_Stamp = -1 // minutes
+	Datetime_h  Datetime_Stamp = -2 // hours
+)
+
+// Enum value maps for Datetime_Stamp.
+var (
+	Datetime_Stamp_name = map[int32]string{
+		0:  "ms",
+		1:  "s",
+		2:  "mc",
+		3:  "ns",
+		-1: "m",
+		-2: "h",
+	}
+	Datetime_Stamp_value = map[string]int32{
+		"ms": 0,
+		"s":  1,
+		"mc": 2,
+		"ns": 3,
+		"m":  -1,
+		"h":  -2,
+	}
+)
+
+func (x Datetime_Stamp) Enum() *Datetime_Stamp {
+	p := new(Datetime_Stamp)
+	*p = x
+	return p
+}
+
+func (x Datetime_Stamp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Datetime_Stamp) Descriptor() protoreflect.EnumDescriptor {
+	return file_custom_data_primitive_proto_enumTypes[3].Descriptor()
+}
+
+func (Datetime_Stamp) Type() protoreflect.EnumType {
+	return &file_custom_data_primitive_proto_enumTypes[3]
+}
+
+func (x Datetime_Stamp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Datetime_Stamp.Descriptor instead.
+func (Datetime_Stamp) EnumDescriptor() ([]byte, []int) {
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{9, 1}
+}
+
 type Bool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -163,6 +340,7 @@ type Int struct {
 	Max *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
 	// DESIGN. Custom error(s) on spec. constraint violation.
 	// map < constraint, template > to produce [status.message].
+	// Placeholder(s): {value}, {min}, {max}, {field}.
 	//
 	//	{
 	//	  "min": "country code {value} MUST contain at least 2 digits"
@@ -358,6 +536,102 @@ func (x *Float) GetViolation() map[string]string {
 	return nil
 }
 
+// Fixed-point (exact) decimal number type descriptor.
+// Also used by the [money] kind with the [currency] required.
+type Decimal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total count of significant digits.
+	// Zero - arbitrary precision.
+	Precision uint32 `protobuf:"varint,1,opt,name=precision,proto3" json:"precision,omitempty"`
+	// Count of the fractional part digits.
+	// [money] Default: currency minor unit(s).
+	Scale uint32 `protobuf:"varint,2,opt,name=scale,proto3" json:"scale,omitempty"`
+	// Boundaries, as a decimal string, e.g.: "0.01".
+	Min string `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max string `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	// [ "precision", "scale", "min", "max" ]
+	Violation map[string]string `protobuf:"bytes,5,rep,name=violation,proto3" json:"violation,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// [money] ISO-4217 currency code, e.g.: "EUR", "UAH", "USD".
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *Decimal) Reset() {
+	*x = Decimal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_custom_data_primitive_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decimal) ProtoMessage() {}
+
+func (x *Decimal) ProtoReflect() protoreflect.Message {
+	mi := &file_custom_data_primitive_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decimal.ProtoReflect.Descriptor instead.
+func (*Decimal) Descriptor() ([]byte, []int) {
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Decimal) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *Decimal) GetScale() uint32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *Decimal) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *Decimal) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *Decimal) GetViolation() map[string]string {
+	if x != nil {
+		return x.Violation
+	}
+	return nil
+}
+
+func (x *Decimal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type Text struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -367,12 +641,20 @@ type Text struct {
 	MaxChars  uint32            `protobuf:"varint,2,opt,name=max_chars,json=maxChars,proto3" json:"max_chars,omitempty"`
 	Multiline bool              `protobuf:"varint,3,opt,name=multiline,proto3" json:"multiline,omitempty"`
 	Violation map[string]string `protobuf:"bytes,5,rep,name=violation,proto3" json:"violation,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Accept format ; RE2 regular expression
+	// the value MUST match, e.g.: "^[A-Z]{2}[0-9]{6}$".
+	AcceptRegex string `protobuf:"bytes,7,opt,name=accept_regex,json=acceptRegex,proto3" json:"accept_regex,omitempty"`
+	// Equality strategy ; case-insensitive
+	// comparison, uniqueness and search.
+	IgnoreCase bool `protobuf:"varint,8,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// Accept format preset.
+	Preset Text_Preset `protobuf:"varint,9,opt,name=preset,proto3,enum=webitel.custom.data.Text_Preset" json:"preset,omitempty"`
 }
 
 func (x *Text) Reset() {
 	*x = Text{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_data_primitive_proto_msgTypes[4]
+		mi := &file_custom_data_primitive_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +667,7 @@ func (x *Text) String() string {
 func (*Text) ProtoMessage() {}
 
 func (x *Text) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_data_primitive_proto_msgTypes[4]
+	mi := &file_custom_data_primitive_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +680,7 @@ func (x *Text) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Text.ProtoReflect.Descriptor instead.
 func (*Text) Descriptor() ([]byte, []int) {
-	return file_custom_data_primitive_proto_rawDescGZIP(), []int{4}
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Text) GetMaxBytes() uint32 {
@@ -429,6 +711,27 @@ func (x *Text) GetViolation() map[string]string {
 	return nil
 }
 
+func (x *Text) GetAcceptRegex() string {
+	if x != nil {
+		return x.AcceptRegex
+	}
+	return ""
+}
+
+func (x *Text) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *Text) GetPreset() Text_Preset {
+	if x != nil {
+		return x.Preset
+	}
+	return Text_none
+}
+
 type Binary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -441,7 +744,7 @@ type Binary struct {
 func (x *Binary) Reset() {
 	*x = Binary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_data_primitive_proto_msgTypes[5]
+		mi := &file_custom_data_primitive_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -454,7 +757,7 @@ func (x *Binary) String() string {
 func (*Binary) ProtoMessage() {}
 
 func (x *Binary) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_data_primitive_proto_msgTypes[5]
+	mi := &file_custom_data_primitive_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +770,7 @@ func (x *Binary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Binary.ProtoReflect.Descriptor instead.
 func (*Binary) Descriptor() ([]byte, []int) {
-	return file_custom_data_primitive_proto_rawDescGZIP(), []int{5}
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Binary) GetMaxBytes() uint32 {
@@ -512,7 +815,7 @@ type Lookup struct {
 func (x *Lookup) Reset() {
 	*x = Lookup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_data_primitive_proto_msgTypes[6]
+		mi := &file_custom_data_primitive_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -525,7 +828,7 @@ func (x *Lookup) String() string {
 func (*Lookup) ProtoMessage() {}
 
 func (x *Lookup) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_data_primitive_proto_msgTypes[6]
+	mi := &file_custom_data_primitive_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +841,7 @@ func (x *Lookup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Lookup.ProtoReflect.Descriptor instead.
 func (*Lookup) Descriptor() ([]byte, []int) {
-	return file_custom_data_primitive_proto_rawDescGZIP(), []int{6}
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Lookup) GetName() string {
@@ -583,6 +886,64 @@ func (x *Lookup) GetViolation() map[string]string {
 	return nil
 }
 
+// Enumeration (choice) type descriptor.
+type Enum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// [Required]. Ordered list of the option(s).
+	Options []*Enum_Option `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	// [ "not_found" ]
+	Violation map[string]string `protobuf:"bytes,5,rep,name=violation,proto3" json:"violation,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Enum) Reset() {
+	*x = Enum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_custom_data_primitive_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enum) ProtoMessage() {}
+
+func (x *Enum) ProtoReflect() protoreflect.Message {
+	mi := &file_custom_data_primitive_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enum.ProtoReflect.Descriptor instead.
+func (*Enum) Descriptor() ([]byte, []int) {
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Enum) GetOptions() []*Enum_Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Enum) GetViolation() map[string]string {
+	if x != nil {
+		return x.Violation
+	}
+	return nil
+}
+
 // Datetime type settings.
 type Datetime struct {
 	state         protoimpl.MessageState
@@ -590,21 +951,30 @@ type Datetime struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Timezone associated.
+	// IANA zone name -or- UTC offset, e.g.: "Europe/Kyiv", "+03:00".
+	// Input without UTC offset is treated as the local time of this zone.
 	// Default: `UTC`.
 	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
-	// Custom EPOCH timestamp.
+	// Custom EPOCH timestamp ; UNIX time in seconds.
+	// Numeric value(s) are counted since this time.
 	// If zero - UNIX epoch (1970-01-01 00:00:00) will be used.
 	Epoch float64 `protobuf:"fixed64,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	// Display format.
 	// See [layouts](https://pkg.go.dev/time#pkg-constants) for details.
 	// Default: `Mon, 02 Jan 2006 15:04:05 -0700`; [time.RFC1123Z]
 	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Part of: [ date &| time ].
+	// Default: `full`.
+	Part Datetime_Part `protobuf:"varint,4,opt,name=part,proto3,enum=webitel.custom.data.Datetime_Part" json:"part,omitempty"`
+	// Time precision.
+	// Default: `ms`.
+	Stamp Datetime_Stamp `protobuf:"varint,5,opt,name=stamp,proto3,enum=webitel.custom.data.Datetime_Stamp" json:"stamp,omitempty"`
 }
 
 func (x *Datetime) Reset() {
 	*x = Datetime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_data_primitive_proto_msgTypes[7]
+		mi := &file_custom_data_primitive_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +987,7 @@ func (x *Datetime) String() string {
 func (*Datetime) ProtoMessage() {}
 
 func (x *Datetime) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_data_primitive_proto_msgTypes[7]
+	mi := &file_custom_data_primitive_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +1000,7 @@ func (x *Datetime) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Datetime.ProtoReflect.Descriptor instead.
 func (*Datetime) Descriptor() ([]byte, []int) {
-	return file_custom_data_primitive_proto_rawDescGZIP(), []int{7}
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Datetime) GetZone() string {
@@ -654,6 +1024,20 @@ func (x *Datetime) GetFormat() string {
 	return ""
 }
 
+func (x *Datetime) GetPart() Datetime_Part {
+	if x != nil {
+		return x.Part
+	}
+	return Datetime_full
+}
+
+func (x *Datetime) GetStamp() Datetime_Stamp {
+	if x != nil {
+		return x.Stamp
+	}
+	return Datetime_ms
+}
+
 // Duration
 type Duration struct {
 	state         protoimpl.MessageState
@@ -663,15 +1047,20 @@ type Duration struct {
 	Min       *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
 	Max       *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
 	Violation map[string]string      `protobuf:"bytes,5,rep,name=violation,proto3" json:"violation,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Display format.
-	// TODO: `hh:mm:ss.ms`
+	// Display format, one of:
+	// ""         - number of seconds, e.g.: 5400.25 ; default
+	// "iso8601"  - ISO-8601, e.g.: PT1H30M0.25S
+	// "go"       - Go, e.g.: 1h30m0.25s
+	// "clock"    - hh:mm:ss[.ms], e.g.: 01:30:00.250
+	// "interval" - PostgreSQL interval, e.g.: 01:30:00.25
+	// Input is accepted in any of these formats.
 	Format string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
 }
 
 func (x *Duration) Reset() {
 	*x = Duration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_data_primitive_proto_msgTypes[8]
+		mi := &file_custom_data_primitive_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +1073,7 @@ func (x *Duration) String() string {
 func (*Duration) ProtoMessage() {}
 
 func (x *Duration) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_data_primitive_proto_msgTypes[8]
+	mi := &file_custom_data_primitive_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +1086,7 @@ func (x *Duration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Duration.ProtoReflect.Descriptor instead.
 func (*Duration) Descriptor() ([]byte, []int) {
-	return file_custom_data_primitive_proto_rawDescGZIP(), []int{8}
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Duration) GetMin() *wrapperspb.Int64Value {
@@ -762,7 +1151,7 @@ type DataType struct {
 func (x *DataType) Reset() {
 	*x = DataType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_data_primitive_proto_msgTypes[9]
+		mi := &file_custom_data_primitive_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -775,7 +1164,7 @@ func (x *DataType) String() string {
 func (*DataType) ProtoMessage() {}
 
 func (x *DataType) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_data_primitive_proto_msgTypes[9]
+	mi := &file_custom_data_primitive_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1177,7 @@ func (x *DataType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataType.ProtoReflect.Descriptor instead.
 func (*DataType) Descriptor() ([]byte, []int) {
-	return file_custom_data_primitive_proto_rawDescGZIP(), []int{9}
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DataType) GetKind() Kind {
@@ -1017,6 +1406,95 @@ func (*DataType_Datetime) isDataType_Type() {}
 
 func (*DataType_Duration) isDataType_Type() {}
 
+// Option of the enumeration.
+type Enum_Option struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// [Required]. Unique option code.
+	// The value that is stored, e.g.: "active".
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Optional. Default display title.
+	// Default: `code`.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Localized display title(s).
+	// map < locale, title >, e.g.: { "uk": "Активний" }
+	Title map[string]string `protobuf:"bytes,3,rep,name=title,proto3" json:"title,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional. Display color, e.g.: "#4caf50".
+	Color string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	// Deprecated option is still valid for the existing data,
+	// but SHOULD NOT be offered for the new input any more.
+	Deprecated bool `protobuf:"varint,5,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+}
+
+func (x *Enum_Option) Reset() {
+	*x = Enum_Option{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_custom_data_primitive_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enum_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enum_Option) ProtoMessage() {}
+
+func (x *Enum_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_custom_data_primitive_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enum_Option.ProtoReflect.Descriptor instead.
+func (*Enum_Option) Descriptor() ([]byte, []int) {
+	return file_custom_data_primitive_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Enum_Option) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Enum_Option) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Enum_Option) GetTitle() map[string]string {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *Enum_Option) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Enum_Option) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
 var File_custom_data_primitive_proto protoreflect.FileDescriptor
 
 var file_custom_data_primitive_proto_rawDesc = []byte{
@@ -1071,152 +1549,224 @@ var file_custom_data_primitive_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x04, 0x54, 0x65,
-	0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x76, 0x69,
-	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xad, 0x01, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x49, 0x0a,
+	0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x9f, 0x03, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
 	0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x76, 0x69, 0x6f, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65,
-	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xe4, 0x02, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
-	0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
-	0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0x38, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x69, 0x6f,
+	0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x69, 0x6f,
 	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x65, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x69,
-	0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x61, 0x78,
-	0x12, 0x4a, 0x0a, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x99, 0x07, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
-	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2f,
-	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x10, 0x04, 0x22, 0xad, 0x01, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x09,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xe4, 0x02, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x09, 0x76, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77,
 	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12,
-	0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x12, 0x2c, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e,
-	0x74, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06,
-	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
-	0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2f, 0x0a, 0x04, 0x75,
-	0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x61, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x38, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a,
+	0x0e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xae, 0x03, 0x0a, 0x04,
+	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x46, 0x0a, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xe3, 0x01, 0x0a, 0x06, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x1a, 0x38, 0x0a, 0x0a, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x02, 0x0a,
+	0x08, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x70,
+	0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x62, 0x69,
 	0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x55, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x07,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x52, 0x04, 0x70,
+	0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x24,
+	0x0a, 0x04, 0x50, 0x61, 0x72, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x10, 0x02, 0x22, 0x46, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x06, 0x0a,
+	0x02, 0x6d, 0x73, 0x10, 0x00, 0x12, 0x05, 0x0a, 0x01, 0x73, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02,
+	0x6d, 0x63, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x6e, 0x73, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x01,
+	0x6d, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x0e, 0x0a, 0x01,
+	0x68, 0x10, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x22, 0x8a, 0x02, 0x0a,
+	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x4a, 0x0a, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x07, 0x0a, 0x08, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00,
+	0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2c, 0x0a, 0x03, 0x69, 0x6e,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55,
+	0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x33, 0x0a,
+	0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x33, 0x32, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x12, 0x32, 0x0a, 0x05,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65,
-	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x12, 0x35, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52,
-	0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
-	0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x33,
-	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x61, 0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x75,
+	0x69, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x48, 0x00, 0x52, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x12, 0x36, 0x0a, 0x07, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x36, 0x34, 0x12, 0x32, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x42, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x35,
+	0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x00, 0x52, 0x06, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
 	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65, 0x78, 0x74,
-	0x48, 0x00, 0x52, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3b, 0x0a, 0x08,
-	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52,
-	0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65,
-	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0xd8,
-	0x01, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x62,
-	0x6f, 0x6f, 0x6c, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x09,
-	0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x10, 0x06, 0x12, 0x0a,
-	0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x10,
-	0x09, 0x12, 0x0b, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x10, 0x0a, 0x12, 0x0b,
-	0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x62,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x10, 0x0c, 0x12, 0x0a, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x10, 0x0d, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x0e, 0x12,
-	0x0c, 0x0a, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x10, 0x0f, 0x12, 0x0c, 0x0a,
-	0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x10, 0x10, 0x12, 0x0c, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x11, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x74, 0x61, 0x3b, 0x64, 0x61, 0x74, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x69,
+	0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x3b, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x86, 0x02, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x08,
+	0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x69, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x75,
+	0x69, 0x6e, 0x74, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x10,
+	0x07, 0x12, 0x0a, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x10, 0x08, 0x12, 0x09, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x33, 0x32, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34,
+	0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x10, 0x0c, 0x12, 0x0a,
+	0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x10, 0x0d, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x10, 0x0e, 0x12, 0x0c, 0x0a, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74, 0x65,
+	0x78, 0x74, 0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x10, 0x10, 0x12, 0x0c, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x11,
+	0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x10, 0x12, 0x12, 0x08, 0x0a, 0x04,
+	0x65, 0x6e, 0x75, 0x6d, 0x10, 0x13, 0x12, 0x0b, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x10, 0x14, 0x12, 0x09, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x10, 0x15, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x3b, 0x64, 0x61, 0x74, 0x61, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1231,71 +1781,87 @@ func file_custom_data_primitive_proto_rawDescGZIP() []byte {
 	return file_custom_data_primitive_proto_rawDescData
 }
 
-var file_custom_data_primitive_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_custom_data_primitive_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_custom_data_primitive_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_custom_data_primitive_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_custom_data_primitive_proto_goTypes = []any{
 	(Kind)(0),                      // 0: webitel.custom.data.Kind
-	(*Bool)(nil),                   // 1: webitel.custom.data.Bool
-	(*Int)(nil),                    // 2: webitel.custom.data.Int
-	(*Uint)(nil),                   // 3: webitel.custom.data.Uint
-	(*Float)(nil),                  // 4: webitel.custom.data.Float
-	(*Text)(nil),                   // 5: webitel.custom.data.Text
-	(*Binary)(nil),                 // 6: webitel.custom.data.Binary
-	(*Lookup)(nil),                 // 7: webitel.custom.data.Lookup
-	(*Datetime)(nil),               // 8: webitel.custom.data.Datetime
-	(*Duration)(nil),               // 9: webitel.custom.data.Duration
-	(*DataType)(nil),               // 10: webitel.custom.data.DataType
-	nil,                            // 11: webitel.custom.data.Int.ViolationEntry
-	nil,                            // 12: webitel.custom.data.Uint.ViolationEntry
-	nil,                            // 13: webitel.custom.data.Float.ViolationEntry
-	nil,                            // 14: webitel.custom.data.Text.ViolationEntry
-	nil,                            // 15: webitel.custom.data.Binary.ViolationEntry
-	nil,                            // 16: webitel.custom.data.Lookup.QueryEntry
-	nil,                            // 17: webitel.custom.data.Lookup.ViolationEntry
-	nil,                            // 18: webitel.custom.data.Duration.ViolationEntry
-	(*wrapperspb.Int64Value)(nil),  // 19: google.protobuf.Int64Value
-	(*wrapperspb.UInt64Value)(nil), // 20: google.protobuf.UInt64Value
-	(*wrapperspb.DoubleValue)(nil), // 21: google.protobuf.DoubleValue
+	(Text_Preset)(0),               // 1: webitel.custom.data.Text.Preset
+	(Datetime_Part)(0),             // 2: webitel.custom.data.Datetime.Part
+	(Datetime_Stamp)(0),            // 3: webitel.custom.data.Datetime.Stamp
+	(*Bool)(nil),                   // 4: webitel.custom.data.Bool
+	(*Int)(nil),                    // 5: webitel.custom.data.Int
+	(*Uint)(nil),                   // 6: webitel.custom.data.Uint
+	(*Float)(nil),                  // 7: webitel.custom.data.Float
+	(*Decimal)(nil),                // 8: webitel.custom.data.Decimal
+	(*Text)(nil),                   // 9: webitel.custom.data.Text
+	(*Binary)(nil),                 // 10: webitel.custom.data.Binary
+	(*Lookup)(nil),                 // 11: webitel.custom.data.Lookup
+	(*Enum)(nil),                   // 12: webitel.custom.data.Enum
+	(*Datetime)(nil),               // 13: webitel.custom.data.Datetime
+	(*Duration)(nil),               // 14: webitel.custom.data.Duration
+	(*DataType)(nil),               // 15: webitel.custom.data.DataType
+	nil,                            // 16: webitel.custom.data.Int.ViolationEntry
+	nil,                            // 17: webitel.custom.data.Uint.ViolationEntry
+	nil,                            // 18: webitel.custom.data.Float.ViolationEntry
+	nil,                            // 19: webitel.custom.data.Decimal.ViolationEntry
+	nil,                            // 20: webitel.custom.data.Text.ViolationEntry
+	nil,                            // 21: webitel.custom.data.Binary.ViolationEntry
+	nil,                            // 22: webitel.custom.data.Lookup.QueryEntry
+	nil,                            // 23: webitel.custom.data.Lookup.ViolationEntry
+	(*Enum_Option)(nil),            // 24: webitel.custom.data.Enum.Option
+	nil,                            // 25: webitel.custom.data.Enum.ViolationEntry
+	nil,                            // 26: webitel.custom.data.Enum.Option.TitleEntry
+	nil,                            // 27: webitel.custom.data.Duration.ViolationEntry
+	(*wrapperspb.Int64Value)(nil),  // 28: google.protobuf.Int64Value
+	(*wrapperspb.UInt64Value)(nil), // 29: google.protobuf.UInt64Value
+	(*wrapperspb.DoubleValue)(nil), // 30: google.protobuf.DoubleValue
 }
 var file_custom_data_primitive_proto_depIdxs = []int32{
-	19, // 0: webitel.custom.data.Int.min:type_name -> google.protobuf.Int64Value
-	19, // 1: webitel.custom.data.Int.max:type_name -> google.protobuf.Int64Value
-	11, // 2: webitel.custom.data.Int.violation:type_name -> webitel.custom.data.Int.ViolationEntry
-	20, // 3: webitel.custom.data.Uint.min:type_name -> google.protobuf.UInt64Value
-	20, // 4: webitel.custom.data.Uint.max:type_name -> google.protobuf.UInt64Value
-	12, // 5: webitel.custom.data.Uint.violation:type_name -> webitel.custom.data.Uint.ViolationEntry
-	21, // 6: webitel.custom.data.Float.min:type_name -> google.protobuf.DoubleValue
-	21, // 7: webitel.custom.data.Float.max:type_name -> google.protobuf.DoubleValue
-	13, // 8: webitel.custom.data.Float.violation:type_name -> webitel.custom.data.Float.ViolationEntry
-	14, // 9: webitel.custom.data.Text.violation:type_name -> webitel.custom.data.Text.ViolationEntry
-	15, // 10: webitel.custom.data.Binary.violation:type_name -> webitel.custom.data.Binary.ViolationEntry
-	16, // 11: webitel.custom.data.Lookup.query:type_name -> webitel.custom.data.Lookup.QueryEntry
-	17, // 12: webitel.custom.data.Lookup.violation:type_name -> webitel.custom.data.Lookup.ViolationEntry
-	19, // 13: webitel.custom.data.Duration.min:type_name -> google.protobuf.Int64Value
-	19, // 14: webitel.custom.data.Duration.max:type_name -> google.protobuf.Int64Value
-	18, // 15: webitel.custom.data.Duration.violation:type_name -> webitel.custom.data.Duration.ViolationEntry
-	0,  // 16: webitel.custom.data.DataType.kind:type_name -> webitel.custom.data.Kind
-	1,  // 17: webitel.custom.data.DataType.bool:type_name -> webitel.custom.data.Bool
-	2,  // 18: webitel.custom.data.DataType.int32:type_name -> webitel.custom.data.Int
-	2,  // 19: webitel.custom.data.DataType.int64:type_name -> webitel.custom.data.Int
-	2,  // 20: webitel.custom.data.DataType.int:type_name -> webitel.custom.data.Int
-	3,  // 21: webitel.custom.data.DataType.uint32:type_name -> webitel.custom.data.Uint
-	3,  // 22: webitel.custom.data.DataType.uint64:type_name -> webitel.custom.data.Uint
-	3,  // 23: webitel.custom.data.DataType.uint:type_name -> webitel.custom.data.Uint
-	4,  // 24: webitel.custom.data.DataType.float32:type_name -> webitel.custom.data.Float
-	4,  // 25: webitel.custom.data.DataType.float64:type_name -> webitel.custom.data.Float
-	4,  // 26: webitel.custom.data.DataType.float:type_name -> webitel.custom.data.Float
-	6,  // 27: webitel.custom.data.DataType.binary:type_name -> webitel.custom.data.Binary
-	7,  // 28: webitel.custom.data.DataType.lookup:type_name -> webitel.custom.data.Lookup
-	5,  // 29: webitel.custom.data.DataType.string:type_name -> webitel.custom.data.Text
-	5,  // 30: webitel.custom.data.DataType.richtext:type_name -> webitel.custom.data.Text
-	8,  // 31: webitel.custom.data.DataType.datetime:type_name -> webitel.custom.data.Datetime
-	9,  // 32: webitel.custom.data.DataType.duration:type_name -> webitel.custom.data.Duration
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	28, // 0: webitel.custom.data.Int.min:type_name -> google.protobuf.Int64Value
+	28, // 1: webitel.custom.data.Int.max:type_name -> google.protobuf.Int64Value
+	16, // 2: webitel.custom.data.Int.violation:type_name -> webitel.custom.data.Int.ViolationEntry
+	29, // 3: webitel.custom.data.Uint.min:type_name -> google.protobuf.UInt64Value
+	29, // 4: webitel.custom.data.Uint.max:type_name -> google.protobuf.UInt64Value
+	17, // 5: webitel.custom.data.Uint.violation:type_name -> webitel.custom.data.Uint.ViolationEntry
+	30, // 6: webitel.custom.data.Float.min:type_name -> google.protobuf.DoubleValue
+	30, // 7: webitel.custom.data.Float.max:type_name -> google.protobuf.DoubleValue
+	18, // 8: webitel.custom.data.Float.violation:type_name -> webitel.custom.data.Float.ViolationEntry
+	19, // 9: webitel.custom.data.Decimal.violation:type_name -> webitel.custom.data.Decimal.ViolationEntry
+	20, // 10: webitel.custom.data.Text.violation:type_name -> webitel.custom.data.Text.ViolationEntry
+	1,  // 11: webitel.custom.data.Text.preset:type_name -> webitel.custom.data.Text.Preset
+	21, // 12: webitel.custom.data.Binary.violation:type_name -> webitel.custom.data.Binary.ViolationEntry
+	22, // 13: webitel.custom.data.Lookup.query:type_name -> webitel.custom.data.Lookup.QueryEntry
+	23, // 14: webitel.custom.data.Lookup.violation:type_name -> webitel.custom.data.Lookup.ViolationEntry
+	24, // 15: webitel.custom.data.Enum.options:type_name -> webitel.custom.data.Enum.Option
+	25, // 16: webitel.custom.data.Enum.violation:type_name -> webitel.custom.data.Enum.ViolationEntry
+	2,  // 17: webitel.custom.data.Datetime.part:type_name -> webitel.custom.data.Datetime.Part
+	3,  // 18: webitel.custom.data.Datetime.stamp:type_name -> webitel.custom.data.Datetime.Stamp
+	28, // 19: webitel.custom.data.Duration.min:type_name -> google.protobuf.Int64Value
+	28, // 20: webitel.custom.data.Duration.max:type_name -> google.protobuf.Int64Value
+	27, // 21: webitel.custom.data.Duration.violation:type_name -> webitel.custom.data.Duration.ViolationEntry
+	0,  // 22: webitel.custom.data.DataType.kind:type_name -> webitel.custom.data.Kind
+	4,  // 23: webitel.custom.data.DataType.bool:type_name -> webitel.custom.data.Bool
+	5,  // 24: webitel.custom.data.DataType.int32:type_name -> webitel.custom.data.Int
+	5,  // 25: webitel.custom.data.DataType.int64:type_name -> webitel.custom.data.Int
+	5,  // 26: webitel.custom.data.DataType.int:type_name -> webitel.custom.data.Int
+	6,  // 27: webitel.custom.data.DataType.uint32:type_name -> webitel.custom.data.Uint
+	6,  // 28: webitel.custom.data.DataType.uint64:type_name -> webitel.custom.data.Uint
+	6,  // 29: webitel.custom.data.DataType.uint:type_name -> webitel.custom.data.Uint
+	7,  // 30: webitel.custom.data.DataType.float32:type_name -> webitel.custom.data.Float
+	7,  // 31: webitel.custom.data.DataType.float64:type_name -> webitel.custom.data.Float
+	7,  // 32: webitel.custom.data.DataType.float:type_name -> webitel.custom.data.Float
+	10, // 33: webitel.custom.data.DataType.binary:type_name -> webitel.custom.data.Binary
+	11, // 34: webitel.custom.data.DataType.lookup:type_name -> webitel.custom.data.Lookup
+	9,  // 35: webitel.custom.data.DataType.string:type_name -> webitel.custom.data.Text
+	9,  // 36: webitel.custom.data.DataType.richtext:type_name -> webitel.custom.data.Text
+	13, // 37: webitel.custom.data.DataType.datetime:type_name -> webitel.custom.data.Datetime
+	14, // 38: webitel.custom.data.DataType.duration:type_name -> webitel.custom.data.Duration
+	26, // 39: webitel.custom.data.Enum.Option.title:type_name -> webitel.custom.data.Enum.Option.TitleEntry
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_custom_data_primitive_proto_init() }
@@ -1353,7 +1919,7 @@ func file_custom_data_primitive_proto_init() {
 			}
 		}
 		file_custom_data_primitive_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Text); i {
+			switch v := v.(*Decimal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,7 +1931,7 @@ func file_custom_data_primitive_proto_init() {
 			}
 		}
 		file_custom_data_primitive_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*Binary); i {
+			switch v := v.(*Text); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,7 +1943,7 @@ func file_custom_data_primitive_proto_init() {
 			}
 		}
 		file_custom_data_primitive_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*Lookup); i {
+			switch v := v.(*Binary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1389,7 +1955,7 @@ func file_custom_data_primitive_proto_init() {
 			}
 		}
 		file_custom_data_primitive_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Datetime); i {
+			switch v := v.(*Lookup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1401,7 +1967,7 @@ func file_custom_data_primitive_proto_init() {
 			}
 		}
 		file_custom_data_primitive_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Duration); i {
+			switch v := v.(*Enum); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1413,6 +1979,30 @@ func file_custom_data_primitive_proto_init() {
 			}
 		}
 		file_custom_data_primitive_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Datetime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_custom_data_primitive_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Duration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_custom_data_primitive_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*DataType); i {
 			case 0:
 				return &v.state
@@ -1424,8 +2014,20 @@ func file_custom_data_primitive_proto_init() {
 				return nil
 			}
 		}
+		file_custom_data_primitive_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*Enum_Option); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_custom_data_primitive_proto_msgTypes[9].OneofWrappers = []any{
+	file_custom_data_primitive_proto_msgTypes[11].OneofWrappers = []any{
 		(*DataType_Bool)(nil),
 		(*DataType_Int32)(nil),
 		(*DataType_Int64)(nil),
@@ -1448,8 +2050,8 @@ func file_custom_data_primitive_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_custom_data_primitive_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      4,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -183,6 +183,55 @@ func (x *Dataset) GetUpdatedBy() *Lookup {
 	return nil
 }
 
+// Nested structure type of the [record] kind field.
+type Struct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fields of the nested structure.
+	Fields []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *Struct) Reset() {
+	*x = Struct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_custom_dataset_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Struct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Struct) ProtoMessage() {}
+
+func (x *Struct) ProtoReflect() protoreflect.Message {
+	mi := &file_custom_dataset_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Struct.ProtoReflect.Descriptor instead.
+func (*Struct) Descriptor() ([]byte, []int) {
+	return file_custom_dataset_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Struct) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 // Field of the struct.
 type Field struct {
 	state         protoimpl.MessageState
@@ -217,6 +266,10 @@ type Field struct {
 	//	*Field_Richtext
 	//	*Field_Datetime
 	//	*Field_Duration
+	//	*Field_Record
+	//	*Field_Enum
+	//	*Field_Decimal
+	//	*Field_Money
 	Type isField_Type `protobuf_oneof:"type"`
 	// Generated field value.
 	//
@@ -241,7 +294,7 @@ type Field struct {
 func (x *Field) Reset() {
 	*x = Field{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_dataset_proto_msgTypes[1]
+		mi := &file_custom_dataset_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -254,7 +307,7 @@ func (x *Field) String() string {
 func (*Field) ProtoMessage() {}
 
 func (x *Field) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_dataset_proto_msgTypes[1]
+	mi := &file_custom_dataset_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +320,7 @@ func (x *Field) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Field.ProtoReflect.Descriptor instead.
 func (*Field) Descriptor() ([]byte, []int) {
-	return file_custom_dataset_proto_rawDescGZIP(), []int{1}
+	return file_custom_dataset_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Field) GetId() string {
@@ -417,6 +470,34 @@ func (x *Field) GetDuration() *data.Duration {
 	return nil
 }
 
+func (x *Field) GetRecord() *Struct {
+	if x, ok := x.GetType().(*Field_Record); ok {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *Field) GetEnum() *data.Enum {
+	if x, ok := x.GetType().(*Field_Enum); ok {
+		return x.Enum
+	}
+	return nil
+}
+
+func (x *Field) GetDecimal() *data.Decimal {
+	if x, ok := x.GetType().(*Field_Decimal); ok {
+		return x.Decimal
+	}
+	return nil
+}
+
+func (x *Field) GetMoney() *data.Decimal {
+	if x, ok := x.GetType().(*Field_Money); ok {
+		return x.Money
+	}
+	return nil
+}
+
 func (m *Field) GetValue() isField_Value {
 	if m != nil {
 		return m.Value
@@ -534,6 +615,22 @@ type Field_Duration struct {
 	Duration *data.Duration `protobuf:"bytes,27,opt,name=duration,proto3,oneof"`
 }
 
+type Field_Record struct {
+	Record *Struct `protobuf:"bytes,28,opt,name=record,proto3,oneof"`
+}
+
+type Field_Enum struct {
+	Enum *data.Enum `protobuf:"bytes,29,opt,name=enum,proto3,oneof"`
+}
+
+type Field_Decimal struct {
+	Decimal *data.Decimal `protobuf:"bytes,30,opt,name=decimal,proto3,oneof"`
+}
+
+type Field_Money struct {
+	Money *data.Decimal `protobuf:"bytes,31,opt,name=money,proto3,oneof"`
+}
+
 func (*Field_Bool) isField_Type() {}
 
 func (*Field_Int32) isField_Type() {}
@@ -566,6 +663,14 @@ func (*Field_Datetime) isField_Type() {}
 
 func (*Field_Duration) isField_Type() {}
 
+func (*Field_Record) isField_Type() {}
+
+func (*Field_Enum) isField_Type() {}
+
+func (*Field_Decimal) isField_Type() {}
+
+func (*Field_Money) isField_Type() {}
+
 type isField_Value interface {
 	isField_Value()
 }
@@ -611,7 +716,7 @@ type Index struct {
 func (x *Index) Reset() {
 	*x = Index{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_dataset_proto_msgTypes[2]
+		mi := &file_custom_dataset_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -624,7 +729,7 @@ func (x *Index) String() string {
 func (*Index) ProtoMessage() {}
 
 func (x *Index) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_dataset_proto_msgTypes[2]
+	mi := &file_custom_dataset_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,7 +742,7 @@ func (x *Index) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Index.ProtoReflect.Descriptor instead.
 func (*Index) Descriptor() ([]byte, []int) {
-	return file_custom_dataset_proto_rawDescGZIP(), []int{2}
+	return file_custom_dataset_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Index) GetUnique() bool {
@@ -672,12 +777,21 @@ type DatasetList struct {
 	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	// Next page available ?
 	Next bool `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	// Opaque cursor of the boundary item to continue the search
+	// in the same direction, if `next` page available.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Total count of the result items, if requested.
+	Total int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// The `total` count is estimated ?
+	Estimated bool `protobuf:"varint,6,opt,name=estimated,proto3" json:"estimated,omitempty"`
+	// Facet counts of the result items, if requested.
+	Facets []*Facet `protobuf:"bytes,7,rep,name=facets,proto3" json:"facets,omitempty"`
 }
 
 func (x *DatasetList) Reset() {
 	*x = DatasetList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_custom_dataset_proto_msgTypes[3]
+		mi := &file_custom_dataset_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -690,7 +804,7 @@ func (x *DatasetList) String() string {
 func (*DatasetList) ProtoMessage() {}
 
 func (x *DatasetList) ProtoReflect() protoreflect.Message {
-	mi := &file_custom_dataset_proto_msgTypes[3]
+	mi := &file_custom_dataset_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +817,7 @@ func (x *DatasetList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatasetList.ProtoReflect.Descriptor instead.
 func (*DatasetList) Descriptor() ([]byte, []int) {
-	return file_custom_dataset_proto_rawDescGZIP(), []int{3}
+	return file_custom_dataset_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DatasetList) GetData() []*Dataset {
@@ -727,6 +841,159 @@ func (x *DatasetList) GetNext() bool {
 	return false
 }
 
+func (x *DatasetList) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *DatasetList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DatasetList) GetEstimated() bool {
+	if x != nil {
+		return x.Estimated
+	}
+	return false
+}
+
+func (x *DatasetList) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// Facet counts of the result items grouped by the field value.
+type Facet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Field name the items are grouped by.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Count of the items per distinct field value.
+	Counts []*FacetCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_custom_dataset_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_custom_dataset_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_custom_dataset_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Facet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Facet) GetCounts() []*FacetCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// Count of the result items with the same field value.
+type FacetCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Field value, as text. Empty for NULL.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Display name of the value, if lookup.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Count of the items.
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_custom_dataset_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_custom_dataset_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_custom_dataset_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_custom_dataset_proto protoreflect.FileDescriptor
 
 var file_custom_dataset_proto_rawDesc = []byte{
@@ -775,97 +1042,132 @@ var file_custom_dataset_proto_rawDesc = []byte{
 	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
 	0x01, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x0b, 0x4a, 0x04, 0x08,
 	0x0f, 0x10, 0x15, 0x4a, 0x04, 0x08, 0x18, 0x10, 0x19, 0x4a, 0x04, 0x08, 0x19, 0x10, 0x1a, 0x22,
-	0xbb, 0x09, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e,
-	0x74, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
-	0x12, 0x2f, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f,
-	0x6c, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2c, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03,
-	0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
-	0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2f, 0x0a,
-	0x04, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65,
+	0x37, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x8e, 0x0b, 0x0a, 0x05, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x6f, 0x6f,
+	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x30, 0x0a, 0x05,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65,
 	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x12, 0x36,
-	0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66,
-	0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36,
-	0x34, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
-	0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x12, 0x32,
-	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x16, 0x20, 0x01,
+	0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2c,
+	0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x33, 0x32, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x12,
+	0x36, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x12, 0x32, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x18, 0x17, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x48,
-	0x00, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48,
+	0x00, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69,
 	0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
+	0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x37,
+	0x0a, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x06, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
-	0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65,
-	0x78, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x69, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3b,
-	0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x48,
-	0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72,
+	0x69, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x00, 0x52, 0x04,
+	0x65, 0x6e, 0x75, 0x6d, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x34,
+	0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x48, 0x01, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x06,
-	0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x48, 0x01, 0x52, 0x06, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x40, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10,
-	0x0b, 0x4a, 0x04, 0x08, 0x1c, 0x10, 0x33, 0x4a, 0x04, 0x08, 0x35, 0x10, 0x3d, 0x22, 0x51, 0x0a,
-	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x22, 0x62, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x6e, 0x65, 0x78, 0x74, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x3b, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x74, 0x61, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x6d,
+	0x6f, 0x6e, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x01, 0x52,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x6c, 0x77, 0x61,
+	0x79, 0x73, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x48, 0x01, 0x52, 0x06, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x3f,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x40, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x0b, 0x4a, 0x04, 0x08,
+	0x20, 0x10, 0x33, 0x4a, 0x04, 0x08, 0x35, 0x10, 0x3d, 0x22, 0x51, 0x0a, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0xdd, 0x01, 0x0a,
+	0x0b, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a,
+	0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x46,
+	0x61, 0x63, 0x65, 0x74, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x05,
+	0x46, 0x61, 0x63, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0x4c, 0x0a, 0x0a, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x2e, 0x5a,
+	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x3b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -880,57 +1182,69 @@ func file_custom_dataset_proto_rawDescGZIP() []byte {
 	return file_custom_dataset_proto_rawDescData
 }
 
-var file_custom_dataset_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_custom_dataset_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_custom_dataset_proto_goTypes = []any{
 	(*Dataset)(nil),        // 0: webitel.custom.Dataset
-	(*Field)(nil),          // 1: webitel.custom.Field
-	(*Index)(nil),          // 2: webitel.custom.Index
-	(*DatasetList)(nil),    // 3: webitel.custom.DatasetList
-	nil,                    // 4: webitel.custom.Dataset.IndicesEntry
-	(*Lookup)(nil),         // 5: webitel.custom.Lookup
-	(data.Kind)(0),         // 6: webitel.custom.data.Kind
-	(*data.Bool)(nil),      // 7: webitel.custom.data.Bool
-	(*data.Int)(nil),       // 8: webitel.custom.data.Int
-	(*data.Uint)(nil),      // 9: webitel.custom.data.Uint
-	(*data.Float)(nil),     // 10: webitel.custom.data.Float
-	(*data.Binary)(nil),    // 11: webitel.custom.data.Binary
-	(*data.Lookup)(nil),    // 12: webitel.custom.data.Lookup
-	(*data.Text)(nil),      // 13: webitel.custom.data.Text
-	(*data.Datetime)(nil),  // 14: webitel.custom.data.Datetime
-	(*data.Duration)(nil),  // 15: webitel.custom.data.Duration
-	(*structpb.Value)(nil), // 16: google.protobuf.Value
+	(*Struct)(nil),         // 1: webitel.custom.Struct
+	(*Field)(nil),          // 2: webitel.custom.Field
+	(*Index)(nil),          // 3: webitel.custom.Index
+	(*DatasetList)(nil),    // 4: webitel.custom.DatasetList
+	(*Facet)(nil),          // 5: webitel.custom.Facet
+	(*FacetCount)(nil),     // 6: webitel.custom.FacetCount
+	nil,                    // 7: webitel.custom.Dataset.IndicesEntry
+	(*Lookup)(nil),         // 8: webitel.custom.Lookup
+	(data.Kind)(0),         // 9: webitel.custom.data.Kind
+	(*data.Bool)(nil),      // 10: webitel.custom.data.Bool
+	(*data.Int)(nil),       // 11: webitel.custom.data.Int
+	(*data.Uint)(nil),      // 12: webitel.custom.data.Uint
+	(*data.Float)(nil),     // 13: webitel.custom.data.Float
+	(*data.Binary)(nil),    // 14: webitel.custom.data.Binary
+	(*data.Lookup)(nil),    // 15: webitel.custom.data.Lookup
+	(*data.Text)(nil),      // 16: webitel.custom.data.Text
+	(*data.Datetime)(nil),  // 17: webitel.custom.data.Datetime
+	(*data.Duration)(nil),  // 18: webitel.custom.data.Duration
+	(*data.Enum)(nil),      // 19: webitel.custom.data.Enum
+	(*data.Decimal)(nil),   // 20: webitel.custom.data.Decimal
+	(*structpb.Value)(nil), // 21: google.protobuf.Value
 }
 var file_custom_dataset_proto_depIdxs = []int32{
-	1,  // 0: webitel.custom.Dataset.fields:type_name -> webitel.custom.Field
-	4,  // 1: webitel.custom.Dataset.indices:type_name -> webitel.custom.Dataset.IndicesEntry
-	5,  // 2: webitel.custom.Dataset.created_by:type_name -> webitel.custom.Lookup
-	5,  // 3: webitel.custom.Dataset.updated_by:type_name -> webitel.custom.Lookup
-	6,  // 4: webitel.custom.Field.kind:type_name -> webitel.custom.data.Kind
-	7,  // 5: webitel.custom.Field.bool:type_name -> webitel.custom.data.Bool
-	8,  // 6: webitel.custom.Field.int32:type_name -> webitel.custom.data.Int
-	8,  // 7: webitel.custom.Field.int64:type_name -> webitel.custom.data.Int
-	8,  // 8: webitel.custom.Field.int:type_name -> webitel.custom.data.Int
-	9,  // 9: webitel.custom.Field.uint32:type_name -> webitel.custom.data.Uint
-	9,  // 10: webitel.custom.Field.uint64:type_name -> webitel.custom.data.Uint
-	9,  // 11: webitel.custom.Field.uint:type_name -> webitel.custom.data.Uint
-	10, // 12: webitel.custom.Field.float32:type_name -> webitel.custom.data.Float
-	10, // 13: webitel.custom.Field.float64:type_name -> webitel.custom.data.Float
-	10, // 14: webitel.custom.Field.float:type_name -> webitel.custom.data.Float
-	11, // 15: webitel.custom.Field.binary:type_name -> webitel.custom.data.Binary
-	12, // 16: webitel.custom.Field.lookup:type_name -> webitel.custom.data.Lookup
-	13, // 17: webitel.custom.Field.string:type_name -> webitel.custom.data.Text
-	13, // 18: webitel.custom.Field.richtext:type_name -> webitel.custom.data.Text
-	14, // 19: webitel.custom.Field.datetime:type_name -> webitel.custom.data.Datetime
-	15, // 20: webitel.custom.Field.duration:type_name -> webitel.custom.data.Duration
-	16, // 21: webitel.custom.Field.default:type_name -> google.protobuf.Value
-	16, // 22: webitel.custom.Field.always:type_name -> google.protobuf.Value
-	0,  // 23: webitel.custom.DatasetList.data:type_name -> webitel.custom.Dataset
-	2,  // 24: webitel.custom.Dataset.IndicesEntry.value:type_name -> webitel.custom.Index
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	2,  // 0: webitel.custom.Dataset.fields:type_name -> webitel.custom.Field
+	7,  // 1: webitel.custom.Dataset.indices:type_name -> webitel.custom.Dataset.IndicesEntry
+	8,  // 2: webitel.custom.Dataset.created_by:type_name -> webitel.custom.Lookup
+	8,  // 3: webitel.custom.Dataset.updated_by:type_name -> webitel.custom.Lookup
+	2,  // 4: webitel.custom.Struct.fields:type_name -> webitel.custom.Field
+	9,  // 5: webitel.custom.Field.kind:type_name -> webitel.custom.data.Kind
+	10, // 6: webitel.custom.Field.bool:type_name -> webitel.custom.data.Bool
+	11, // 7: webitel.custom.Field.int32:type_name -> webitel.custom.data.Int
+	11, // 8: webitel.custom.Field.int64:type_name -> webitel.custom.data.Int
+	11, // 9: webitel.custom.Field.int:type_name -> webitel.custom.data.Int
+	12, // 10: webitel.custom.Field.uint32:type_name -> webitel.custom.data.Uint
+	12, // 11: webitel.custom.Field.uint64:type_name -> webitel.custom.data.Uint
+	12, // 12: webitel.custom.Field.uint:type_name -> webitel.custom.data.Uint
+	13, // 13: webitel.custom.Field.float32:type_name -> webitel.custom.data.Float
+	13, // 14: webitel.custom.Field.float64:type_name -> webitel.custom.data.Float
+	13, // 15: webitel.custom.Field.float:type_name -> webitel.custom.data.Float
+	14, // 16: webitel.custom.Field.binary:type_name -> webitel.custom.data.Binary
+	15, // 17: webitel.custom.Field.lookup:type_name -> webitel.custom.data.Lookup
+	16, // 18: webitel.custom.Field.string:type_name -> webitel.custom.data.Text
+	16, // 19: webitel.custom.Field.richtext:type_name -> webitel.custom.data.Text
+	17, // 20: webitel.custom.Field.datetime:type_name -> webitel.custom.data.Datetime
+	18, // 21: webitel.custom.Field.duration:type_name -> webitel.custom.data.Duration
+	1,  // 22: webitel.custom.Field.record:type_name -> webitel.custom.Struct
+	19, // 23: webitel.custom.Field.enum:type_name -> webitel.custom.data.Enum
+	20, // 24: webitel.custom.Field.decimal:type_name -> webitel.custom.data.Decimal
+	20, // 25: webitel.custom.Field.money:type_name -> webitel.custom.data.Decimal
+	21, // 26: webitel.custom.Field.default:type_name -> google.protobuf.Value
+	21, // 27: webitel.custom.Field.always:type_name -> google.protobuf.Value
+	0,  // 28: webitel.custom.DatasetList.data:type_name -> webitel.custom.Dataset
+	5,  // 29: webitel.custom.DatasetList.facets:type_name -> webitel.custom.Facet
+	6,  // 30: webitel.custom.Facet.counts:type_name -> webitel.custom.FacetCount
+	3,  // 31: webitel.custom.Dataset.IndicesEntry.value:type_name -> webitel.custom.Index
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_custom_dataset_proto_init() }
@@ -953,7 +1267,7 @@ func file_custom_dataset_proto_init() {
 			}
 		}
 		file_custom_dataset_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Field); i {
+			switch v := v.(*Struct); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -965,7 +1279,7 @@ func file_custom_dataset_proto_init() {
 			}
 		}
 		file_custom_dataset_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Index); i {
+			switch v := v.(*Field); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -977,6 +1291,18 @@ func file_custom_dataset_proto_init() {
 			}
 		}
 		file_custom_dataset_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Index); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_custom_dataset_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*DatasetList); i {
 			case 0:
 				return &v.state
@@ -988,8 +1314,32 @@ func file_custom_dataset_proto_init() {
 				return nil
 			}
 		}
+		file_custom_dataset_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Facet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_custom_dataset_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*FacetCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_custom_dataset_proto_msgTypes[1].OneofWrappers = []any{
+	file_custom_dataset_proto_msgTypes[2].OneofWrappers = []any{
 		(*Field_Bool)(nil),
 		(*Field_Int32)(nil),
 		(*Field_Int64)(nil),
@@ -1006,6 +1356,10 @@ func file_custom_dataset_proto_init() {
 		(*Field_Richtext)(nil),
 		(*Field_Datetime)(nil),
 		(*Field_Duration)(nil),
+		(*Field_Record)(nil),
+		(*Field_Enum)(nil),
+		(*Field_Decimal)(nil),
+		(*Field_Money)(nil),
 		(*Field_Default)(nil),
 		(*Field_Always)(nil),
 	}
@@ -1015,7 +1369,7 @@ func file_custom_dataset_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_custom_dataset_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,4 +23,8 @@ const (
 	RICHTEXT      = datapb.Kind_richtext
 	DATETIME      = datapb.Kind_datetime
 	DURATION      = datapb.Kind_duration
+	RECORD        = datapb.Kind_record
+	ENUM          = datapb.Kind_enum
+	DECIMAL       = datapb.Kind_decimal
+	MONEY         = datapb.Kind_money
 )
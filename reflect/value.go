@@ -1,9 +1,11 @@
 package customrel
 
 import (
+	"math/big"
 	"reflect"
 
 	"github.com/webitel/custom/internal/pragma"
+	custompb "github.com/webitel/proto/gen/custom"
 )
 
 // Codec for the data type value convertions
@@ -15,6 +17,7 @@ type Codec interface {
 	// *time.Time
 	// *time.Duration
 	// *Lookup
+	// *big.Rat | DECIMAL, MONEY
 	// []any | LIST
 	// map[string]any | RECORD
 	Interface() any
@@ -68,11 +71,83 @@ func IsNull(v any) bool {
 // int[32|64]
 // uint[32|64]
 // float[32|64]
+// string
+// []byte
 // time.Time
 // time.Duration
 // *Lookup
+// *big.Rat
 // []any | LIST
 // map[string]any | RECORD
 func Indirect(v any) any {
-	panic("not implemented")
+	if IsNull(v) {
+		// NULL
+		return nil
+	}
+	switch e := v.(type) {
+	case Codec:
+		// value of the data type
+		return Indirect(e.Interface())
+	case Record:
+		{
+			// nested structure
+			obj := make(map[string]any)
+			e.Range(func(fd FieldDescriptor, vs any) bool {
+				if vs = Indirect(vs); vs != nil {
+					obj[fd.Name()] = vs
+				}
+				return true
+			})
+			return obj
+		}
+	case *custompb.Lookup:
+		{
+			if e.GetId() == "" && e.GetName() == "" {
+				// NULL
+				return nil
+			}
+			return e
+		}
+	case []byte:
+		// [binary] !
+		return e
+	case *big.Rat:
+		// [decimal] ; exact !
+		return e
+	}
+	// reflect
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			// NULL
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	// LIST
+	case reflect.Slice, reflect.Array:
+		{
+			size := rv.Len()
+			list := make([]any, size)
+			for i := 0; i < size; i++ {
+				list[i] = Indirect(rv.Index(i).Interface())
+			}
+			return list
+		}
+	// RECORD
+	case reflect.Map:
+		{
+			if rv.Type().Key().Kind() != reflect.String {
+				break // as is
+			}
+			obj := make(map[string]any, rv.Len())
+			for iter := rv.MapRange(); iter.Next(); {
+				obj[iter.Key().String()] = Indirect(iter.Value().Interface())
+			}
+			return obj
+		}
+	}
+	// primitive
+	return rv.Interface()
 }
@@ -0,0 +1,40 @@
+package customrel
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	custompb "github.com/webitel/proto/gen/custom"
+)
+
+func TestIndirect(t *testing.T) {
+	var (
+		num  = int64(42)
+		date = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		nilp *int64
+		ref  = &custompb.Lookup{Id: "1", Name: "root"}
+	)
+	tests := []struct {
+		name string
+		v    any
+		want any
+	}{
+		{"nil", nil, nil},
+		{"null", nilp, nil},
+		{"int64", &num, int64(42)},
+		{"time", &date, date},
+		{"lookup", ref, ref},
+		{"lookup.null", &custompb.Lookup{}, nil},
+		{"binary", []byte("data"), []byte("data")},
+		{"list", []*int64{&num, nil}, []any{int64(42), nil}},
+		{"object", map[string]any{"at": &date, "by": ref}, map[string]any{"at": date, "by": ref}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Indirect(tt.v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Indirect() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
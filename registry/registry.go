@@ -96,6 +96,11 @@ func (c *registry) Lookup(dc int64, typeOf string) Dataset {
 
 func (c *registry) Register(ds Dataset) error {
 
+	// [CHECK] structure integrity
+	if err := ds.Err(); err != nil {
+		return err
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
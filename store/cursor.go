@@ -0,0 +1,45 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/webitel/custom/data"
+)
+
+// Cursor is the keyset pagination position of the search result item.
+type Cursor struct {
+	// Sort spec the [Keys] are of, e.g.: "-score,id".
+	Sort string
+	// Keys are the item sort key value(s), as text, in order ; the [primary] one is the last.
+	Keys []*string
+}
+
+// String returns the opaque cursor token.
+func (c *Cursor) String() string {
+	if c == nil || len(c.Keys) == 0 {
+		return ""
+	}
+	list := make([]*string, 0, len(c.Keys)+1)
+	list = append(list, &c.Sort)
+	list = append(list, c.Keys...)
+	text, _ := json.Marshal(list)
+	return base64.RawURLEncoding.EncodeToString(text)
+}
+
+// ParseCursor decodes the opaque cursor [token] ; see Cursor.String.
+func ParseCursor(token string) (*Cursor, error) {
+	var list []*string
+	text, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(text, &list)
+	}
+	if err != nil || len(list) < 2 || list[0] == nil {
+		return nil, data.RequestError(
+			"custom.search.cursor.invalid",
+			"custom: search( cursor: %s ); invalid token",
+			token,
+		)
+	}
+	return &Cursor{Sort: *list[0], Keys: list[1:]}, nil
+}
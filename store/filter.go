@@ -0,0 +1,440 @@
+package store
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
+)
+
+// FilterExpr is the parsed filter expression node ; see ParseFilter.
+//
+//	expr   = term { "or" term }
+//	term   = factor { "and" factor }
+//	factor = "not" factor | "(" expr ")" | cond
+//	cond   = field [ op value ]
+//	op     = "=" | "!=" | "<" | "<=" | ">" | ">="
+//	value  = "null" | word | "quoted" | 'quoted'
+//
+// The sole [field] means it is set: [true] for the BOOL one, NOT NULL otherwise.
+// The [value] with any of '*' or '?' wildcard(s) is the substring of the text field.
+// Keywords are case-insensitive.
+type FilterExpr interface {
+	// String returns the expression canonical form.
+	String() string
+	// Check validates the expression field(s), operator(s) and value(s) against the [typeOf] dataset.
+	Check(typeOf customrel.DatasetDescriptor) error
+
+	filterExpr()
+}
+
+// FilterOp is the comparison operator of the filter condition.
+type FilterOp string
+
+const (
+	FilterSet FilterOp = ""   // field is set ; no value
+	FilterEQ  FilterOp = "="  // equal
+	FilterNE  FilterOp = "!=" // not equal
+	FilterLT  FilterOp = "<"  // less than
+	FilterLE  FilterOp = "<=" // less than or equal
+	FilterGT  FilterOp = ">"  // greater than
+	FilterGE  FilterOp = ">=" // greater than or equal
+)
+
+// Ordered reports whether the [op] is the range comparison.
+func (op FilterOp) Ordered() bool {
+	switch op {
+	case FilterLT, FilterLE, FilterGT, FilterGE:
+		return true
+	}
+	return false
+}
+
+// FilterAnd is the conjunction of the expression(s).
+type FilterAnd []FilterExpr
+
+// FilterOr is the disjunction of the expression(s).
+type FilterOr []FilterExpr
+
+// FilterNot is the negation of the expression.
+type FilterNot struct {
+	X FilterExpr
+}
+
+// FilterCond is the single field condition.
+type FilterCond struct {
+	Field string   // field name
+	Op    FilterOp // comparison
+	Value any      // [string] or nil ; NULL
+}
+
+func (FilterAnd) filterExpr()   {}
+func (FilterOr) filterExpr()    {}
+func (FilterNot) filterExpr()   {}
+func (*FilterCond) filterExpr() {}
+
+func (e FilterAnd) String() string {
+	return filterJoin(e, " and ")
+}
+
+func (e FilterOr) String() string {
+	return filterJoin(e, " or ")
+}
+
+func (e FilterNot) String() string {
+	return "not " + filterGroup(e.X)
+}
+
+func (e *FilterCond) String() string {
+	if e.Op == FilterSet {
+		return e.Field
+	}
+	text, is := e.Value.(string)
+	switch {
+	case e.Value == nil:
+		text = "null"
+	case !is || !filterIsWord(text):
+		text = strconv.Quote(text)
+	}
+	return e.Field + string(e.Op) + text
+}
+
+func filterJoin(list []FilterExpr, sep string) string {
+	var text strings.Builder
+	for i, x := range list {
+		if i > 0 {
+			text.WriteString(sep)
+		}
+		text.WriteString(filterGroup(x))
+	}
+	return text.String()
+}
+
+// filterGroup returns the [x] expression enclosed in parentheses, unless single.
+func filterGroup(x FilterExpr) string {
+	switch x.(type) {
+	case FilterAnd, FilterOr:
+		return "(" + x.String() + ")"
+	}
+	return x.String()
+}
+
+func (e FilterAnd) Check(typeOf customrel.DatasetDescriptor) error {
+	for _, x := range e {
+		if err := x.Check(typeOf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e FilterOr) Check(typeOf customrel.DatasetDescriptor) error {
+	return FilterAnd(e).Check(typeOf)
+}
+
+func (e FilterNot) Check(typeOf customrel.DatasetDescriptor) error {
+	return e.X.Check(typeOf)
+}
+
+// Check the condition field exists, the operator is applicable to it's kind
+// and the value is of the field (list element) type.
+func (e *FilterCond) Check(typeOf customrel.DatasetDescriptor) error {
+	fd := typeOf.Fields().ByName(e.Field)
+	if fd == nil {
+		return data.RequestError(
+			"custom.filter.field.not_found",
+			"custom: %s{%s} no such field",
+			typeOf.Path(), e.Field,
+		)
+	}
+	var (
+		kind = fd.Kind()
+		elem = fd.Type()
+	)
+	if kind == customrel.LIST {
+		elem = elem.(*data.List).Elem()
+	}
+	if e.Op.Ordered() {
+		switch kind {
+		case customrel.INT, customrel.INT32, customrel.INT64,
+			customrel.UINT, customrel.UINT32, customrel.UINT64,
+			customrel.FLOAT, customrel.FLOAT32, customrel.FLOAT64,
+			customrel.DECIMAL, customrel.MONEY,
+			customrel.DATETIME, customrel.DURATION,
+			customrel.STRING:
+		default:
+			return data.RequestError(
+				"custom.filter.operator.invalid",
+				"custom: %s{%s} operator %q is not applicable to the %s field",
+				typeOf.Path(), e.Field, e.Op, kind,
+			)
+		}
+		if e.Value == nil {
+			return data.RequestError(
+				"custom.filter.value.invalid",
+				"custom: %s{%s} operator %q requires value ; got: null",
+				typeOf.Path(), e.Field, e.Op,
+			)
+		}
+	}
+	text, is := e.Value.(string)
+	if !is || e.Op == FilterSet {
+		return nil // NULL
+	}
+	if strings.ContainsAny(text, "*?") {
+		switch elem.Kind() {
+		case customrel.STRING, customrel.RICHTEXT:
+			if !e.Op.Ordered() {
+				return nil // substring
+			}
+		}
+	}
+	if err := elem.New().Decode(text); err != nil {
+		return data.RequestError(
+			"custom.filter.value.invalid",
+			"custom: %s{%s} invalid filter value ; error: %v",
+			typeOf.Path(), e.Field, err,
+		)
+	}
+	return nil
+}
+
+// ParseFilter parses the filter expression [text], e.g.:
+//
+//	name=Kyiv* and population>=100000 or not archived
+//
+// Returns nil expression for the empty [text] ; see FilterExpr.
+func ParseFilter(text string) (FilterExpr, error) {
+	p := filterParser{text: text}
+	p.next()
+	if p.tok.kind == filterEOF {
+		return nil, p.err
+	}
+	expr := p.parseOr()
+	if p.err == nil && p.tok.kind != filterEOF {
+		p.fail("unexpected %s", p.tok)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return expr, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterEOF    filterTokenKind = iota
+	filterWord                   // bare word ; field name, keyword or value
+	filterQuoted                 // quoted string value
+	filterOp                     // comparison operator
+	filterLParen                 // (
+	filterRParen                 // )
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int // offset
+}
+
+func (t filterToken) String() string {
+	if t.kind == filterEOF {
+		return "end of input"
+	}
+	return strconv.Quote(t.text) + " at " + strconv.Itoa(t.pos)
+}
+
+// keyword reports whether the token is the bare [word] given, case-insensitive.
+func (t filterToken) keyword(word string) bool {
+	return t.kind == filterWord && strings.EqualFold(t.text, word)
+}
+
+// filterParser is the recursive descent parser of the FilterExpr.
+type filterParser struct {
+	text string
+	pos  int
+	tok  filterToken
+	err  error
+}
+
+func (p *filterParser) fail(format string, args ...any) {
+	if p.err != nil {
+		return // first one
+	}
+	p.err = data.RequestError(
+		"custom.filter.syntax.invalid",
+		"custom: filter( %s ); "+format,
+		append([]any{p.text}, args...)...,
+	)
+	p.tok = filterToken{kind: filterEOF, pos: len(p.text)}
+}
+
+// next scans the next token.
+func (p *filterParser) next() {
+	if p.err != nil {
+		return
+	}
+	for p.pos < len(p.text) {
+		r, n := utf8.DecodeRuneInString(p.text[p.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.pos += n
+	}
+	p.tok = filterToken{kind: filterEOF, pos: p.pos}
+	if p.pos == len(p.text) {
+		return
+	}
+	start := p.pos
+	switch c := p.text[p.pos]; c {
+	case '(':
+		p.pos++
+		p.tok = filterToken{kind: filterLParen, text: "(", pos: start}
+	case ')':
+		p.pos++
+		p.tok = filterToken{kind: filterRParen, text: ")", pos: start}
+	case '=', '!', '<', '>':
+		p.pos++
+		if p.pos < len(p.text) && p.text[p.pos] == '=' {
+			p.pos++
+		}
+		op := p.text[start:p.pos]
+		if op == "!" || op == "==" {
+			p.fail("invalid operator %q at %d", op, start)
+			return
+		}
+		p.tok = filterToken{kind: filterOp, text: op, pos: start}
+	case '"', '\'':
+		p.pos++
+		var (
+			text strings.Builder
+			esc  bool
+		)
+		for p.pos < len(p.text) {
+			r, n := utf8.DecodeRuneInString(p.text[p.pos:])
+			p.pos += n
+			switch {
+			case esc:
+				esc = false
+			case r == '\\':
+				esc = true
+				continue
+			case r == rune(c):
+				p.tok = filterToken{kind: filterQuoted, text: text.String(), pos: start}
+				return
+			}
+			text.WriteRune(r)
+		}
+		p.fail("unterminated string at %d", start)
+	default:
+		for p.pos < len(p.text) {
+			r, n := utf8.DecodeRuneInString(p.text[p.pos:])
+			if unicode.IsSpace(r) || strings.ContainsRune("()=!<>\"'", r) {
+				break
+			}
+			p.pos += n
+		}
+		p.tok = filterToken{kind: filterWord, text: p.text[start:p.pos], pos: start}
+	}
+}
+
+func (p *filterParser) parseOr() FilterExpr {
+	list := FilterOr{p.parseAnd()}
+	for p.tok.keyword("or") {
+		p.next()
+		list = append(list, p.parseAnd())
+	}
+	if len(list) == 1 {
+		return list[0]
+	}
+	return list
+}
+
+func (p *filterParser) parseAnd() FilterExpr {
+	list := FilterAnd{p.parseNot()}
+	for p.tok.keyword("and") {
+		p.next()
+		list = append(list, p.parseNot())
+	}
+	if len(list) == 1 {
+		return list[0]
+	}
+	return list
+}
+
+func (p *filterParser) parseNot() FilterExpr {
+	switch {
+	case p.tok.keyword("not"):
+		p.next()
+		return FilterNot{X: p.parseNot()}
+	case p.tok.kind == filterLParen:
+		p.next()
+		expr := p.parseOr()
+		if p.tok.kind != filterRParen {
+			p.fail("expected ')' ; got %s", p.tok)
+			return expr
+		}
+		p.next()
+		return expr
+	}
+	return p.parseCond()
+}
+
+func (p *filterParser) parseCond() FilterExpr {
+	name := p.tok
+	if name.kind != filterWord || !filterIsIdent(name.text) ||
+		name.keyword("and") || name.keyword("or") || name.keyword("null") {
+		p.fail("expected field name ; got %s", name)
+		return &FilterCond{}
+	}
+	cond := &FilterCond{Field: name.text}
+	p.next()
+	if p.tok.kind != filterOp {
+		return cond // FilterSet
+	}
+	cond.Op = FilterOp(p.tok.text)
+	p.next()
+	switch value := p.tok; {
+	case value.kind == filterQuoted:
+		cond.Value = value.text
+	case value.keyword("null"):
+		cond.Value = nil
+		if cond.Op != FilterEQ && cond.Op != FilterNE {
+			p.fail("operator %q is not applicable to null at %d", cond.Op, value.pos)
+		}
+	case value.kind == filterWord && !value.keyword("and") && !value.keyword("or") && !value.keyword("not"):
+		cond.Value = value.text
+	default:
+		p.fail("expected value ; got %s", value)
+	}
+	p.next()
+	return cond
+}
+
+// filterIsIdent reports whether the [text] is a valid field name.
+func filterIsIdent(text string) bool {
+	for i, r := range text {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return text != ""
+}
+
+// filterIsWord reports whether the [text] value MAY be written as the bare word.
+func filterIsWord(text string) bool {
+	if text == "" {
+		return false
+	}
+	switch strings.ToLower(text) {
+	case "and", "or", "not", "null":
+		return false
+	}
+	return !strings.ContainsFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune("()=!<>\"'\\", r)
+	})
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/webitel/custom/data"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		text string
+		want string // canonical ; empty means syntax error
+	}{
+		{"", ""},
+		{"name=Kyiv* and population>=100000 or not archived", "(name=Kyiv* and population>=100000) or not archived"},
+		{"NOT (a = 1 OR b != 'x y') AND c=null", `not (a=1 or b!="x y") and c=null`},
+		{`name = "and" or name="say \"hi\""`, `name="and" or name="say \"hi\""`},
+		{"a<1 and (b>2 or c<=3)", "a<1 and (b>2 or c<=3)"},
+		{"a ==1", ""},
+		{"a < null", ""},
+		{"(a=1", ""},
+		{"a=1 b=2", ""},
+		{"a='x", ""},
+		{"and=1", ""},
+	}
+	for _, tt := range tests {
+		expr, err := ParseFilter(tt.text)
+		if tt.text == "" {
+			if expr != nil || err != nil {
+				t.Errorf("ParseFilter(%q) = %v, %v ; want nil", tt.text, expr, err)
+			}
+			continue
+		}
+		if tt.want == "" {
+			var re *data.Error
+			if !errors.As(err, &re) || re.Id != "custom.filter.syntax.invalid" {
+				t.Errorf("ParseFilter(%q) = %v, %v ; want custom.filter.syntax.invalid", tt.text, expr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseFilter(%q) error = %v", tt.text, err)
+			continue
+		}
+		if got := expr.String(); got != tt.want {
+			t.Errorf("ParseFilter(%q) = %s, want %s", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestFilterCheck(t *testing.T) {
+	cities := data.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "cities",
+		Path:    "dictionaries/cities",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "population", Kind: datapb.Kind_int64},
+			{Id: "archived", Kind: datapb.Kind_bool},
+		},
+	})
+	tests := []struct {
+		text string
+		rule string // expected error id, if any
+	}{
+		{"name=Kyiv* and population>=100000 or not archived", ""},
+		{"name>=K", ""},
+		{"country=UA", "custom.filter.field.not_found"},
+		{"archived>true", "custom.filter.operator.invalid"},
+		{"population>many", "custom.filter.value.invalid"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseFilter(tt.text)
+		if err != nil {
+			t.Fatalf("ParseFilter(%q) error = %v", tt.text, err)
+		}
+		err = expr.Check(cities)
+		var re *data.Error
+		if tt.rule == "" && err != nil || tt.rule != "" && (!errors.As(err, &re) || re.Id != tt.rule) {
+			t.Errorf("Check(%q) error = %v, want %q", tt.text, err, tt.rule)
+		}
+	}
+}
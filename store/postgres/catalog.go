@@ -2,8 +2,13 @@ package postgres
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"path"
+	"slices"
+	"strconv"
 	"strings"
 
 	sq "github.com/Masterminds/squirrel"
@@ -11,9 +16,12 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	custom "github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
+	customreg "github.com/webitel/custom/registry"
 	"github.com/webitel/custom/store"
 	custompb "github.com/webitel/proto/gen/custom"
 	datatypb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -56,9 +64,495 @@ func (c *Catalog) Search(opts ...store.SearchOption) (*custompb.DatasetList, err
 	if err != nil {
 		return nil, customSchemaError(err)
 	}
+	rows.Close()
+
+	if total := ctx.total; total != nil && len(page.Data) == 0 {
+		// [NOTE] NO row(s) to carry the count(s) ; see searchTotal.apply(..)
+		err = total.fetchEmpty(ctx.req.Context, dc, ctx.statement)
+		if err != nil {
+			return nil, customSchemaError(err)
+		}
+		page.Total = total.total
+	}
 	return &page, nil
 }
 
+const (
+	// Parameter(s) of the dataset type write statement(s)
+	paramTypeId   = "id"
+	paramTypeDc   = "dc"
+	paramTypeUid  = "uid"
+	paramTypeDir  = "dir"
+	paramTypeName = "scope"
+)
+
+// customDatasetPath splits [CUSTOM] dataset [pkg] path into it's base [dir] and [name].
+// e.g.: "dictionaries/cities" => ("dictionaries", "cities")
+func customDatasetPath(pkg string) (dir, name string) {
+	dir, name = path.Split(strings.Trim(pkg, "/"))
+	return strings.TrimSuffix(dir, "/"), name
+}
+
+// customDatasetCheck asserts [typeOf] is a valid [CUSTOM] dataset type to be stored.
+func customDatasetCheck(typeOf customrel.DatasetDescriptor) error {
+	if typeOf == nil {
+		return custom.RequestError(
+			"custom.dataset.type.required",
+			"custom: dataset type required but missing",
+		)
+	}
+	if typeOf.Dc() < 1 {
+		return custom.RequestError(
+			"custom.dataset.readonly",
+			"custom: dataset( %s ) is readonly",
+			typeOf.Path(),
+		)
+	}
+	// [NOTE] There is NO catalog column to keep the indices specification ;
+	// reject rather than silently drop it ; see Catalog.SyncIndices
+	if typeOf.Indices().Num() > 0 {
+		return custom.RequestError(
+			"custom.dataset.indices.unsupported",
+			"custom: dataset( %s ) indices are not stored with the type",
+			typeOf.Path(),
+		)
+	}
+	return typeOf.Err()
+}
+
+// Create new [CUSTOM] dataset type on behalf of the [uid] user.
+//
+// [NOTE] The indices specification is NOT stored with the type,
+// so the non-empty one is rejected ; see customDatasetCheck.
+// The dataset table index(es) are the state of their own,
+// reconciled by SyncIndices ; see customIndexQuery.
+func (c *Catalog) Create(ctx context.Context, uid int64, typeOf customrel.DatasetDescriptor) (*custompb.Dataset, error) {
+
+	err := customDatasetCheck(typeOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		spec     = typeOf.ProtoDescriptor()
+		id       int64
+		modified pgtype.Timestamp
+		insertQ  = customDatasetInsertQuery(uid, typeOf)
+	)
+
+	err = pgx.BeginFunc(ctx, c.primary(), func(tx pgx.Tx) error {
+		query, args, err := insertQ.ToSql()
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRow(ctx, query, args...).Scan(&id, &modified)
+		if err != nil {
+			return err
+		}
+		return customFieldInsert(ctx, tx, id, typeOf)
+	})
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+
+	// [NOTE] the registry MAY remember it's absence !
+	_ = customreg.Invalidate(typeOf.Dc(), typeOf.Path())
+
+	spec.CreatedAt = modified.Time.UnixMilli()
+	spec.CreatedBy = customUserRef(uid)
+	spec.UpdatedAt = spec.CreatedAt
+	spec.UpdatedBy = spec.CreatedBy
+	return spec, nil
+}
+
+// customDatasetInsertQuery returns the [typeOf] dataset type INSERT statement
+// on behalf of the [uid] user, RETURNING it's [id] and [created_at] timestamp.
+func customDatasetInsertQuery(uid int64, typeOf customrel.DatasetDescriptor) statement {
+	var (
+		spec    = typeOf.ProtoDescriptor()
+		dir, dn = customDatasetPath(typeOf.Path())
+	)
+	return statement{
+		Query: psql.
+			Insert(sqlident{schemaCustom, tableType}.String()).
+			Columns(
+				columnDc, columnTypeDir, columnTypeName,
+				columnTypeTitle, columnTypeUsage,
+				CustomSqlIdentifier(columnTypeFieldPrimary),
+				CustomSqlIdentifier(columnTypeFieldDisplay),
+				columnCreatedAt, columnCreatedBy,
+				columnUpdatedAt, columnUpdatedBy,
+			).
+			Values(
+				sq.Expr(":"+paramTypeDc),
+				sq.Expr(":"+paramTypeDir),
+				sq.Expr(":"+paramTypeName),
+				sq.Expr(":title"),
+				sq.Expr(":usage"),
+				sq.Expr(":primary"),
+				sq.Expr(":display"),
+				sq.Expr("LOCALTIMESTAMP"), sq.Expr(":"+paramTypeUid),
+				sq.Expr("LOCALTIMESTAMP"), sq.Expr(":"+paramTypeUid),
+			).
+			Suffix(fmt.Sprintf(
+				"RETURNING %s, %s",
+				columnTypeId, columnCreatedAt,
+			)),
+		Params: map[string]any{
+			paramTypeDc:   typeOf.Dc(),
+			paramTypeUid:  customUserId(uid),
+			paramTypeDir:  dir,
+			paramTypeName: dn,
+			"title":       spec.GetName(),
+			"usage":       customTextValue(spec.GetAbout()),
+			"primary":     typeOf.Primary().Name(),
+			"display":     typeOf.Display().Name(),
+		},
+	}
+}
+
+// Update [CUSTOM] dataset type structure on behalf of the [uid] user.
+// This return (nil, NotFound) if not found.
+//
+// [NOTE] The indices specification is NOT stored with the type ; see Create.
+func (c *Catalog) Update(ctx context.Context, uid int64, typeOf customrel.DatasetDescriptor) (*custompb.Dataset, error) {
+
+	err := customDatasetCheck(typeOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		spec    = typeOf.ProtoDescriptor()
+		id      int64
+		created pgtype.Timestamp
+		updated pgtype.Timestamp
+		author  pgtype.Int8
+		updateQ = customDatasetUpdateQuery(uid, typeOf)
+	)
+
+	err = pgx.BeginFunc(ctx, c.primary(), func(tx pgx.Tx) error {
+		query, args, err := updateQ.ToSql()
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRow(ctx, query, args...).Scan(
+			&id, &created, &author, &updated,
+		)
+		if err != nil {
+			return err
+		}
+		// [RE]WRITE fields structure
+		_, err = tx.Exec(ctx, fmt.Sprintf(
+			"DELETE FROM %s WHERE %s = $1",
+			sqlident{schemaCustom, tableField}, columnFieldOf,
+		), id)
+		if err != nil {
+			return err
+		}
+		return customFieldInsert(ctx, tx, id, typeOf)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, custom.NotFoundError(
+			"custom.dataset.not_found",
+			"custom: dataset( %s ) not found",
+			typeOf.Path(),
+		)
+	}
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+
+	_ = customreg.Invalidate(typeOf.Dc(), typeOf.Path())
+
+	spec.CreatedAt = created.Time.UnixMilli()
+	if author.Valid {
+		spec.CreatedBy = customUserRef(author.Int64)
+	}
+	spec.UpdatedAt = updated.Time.UnixMilli()
+	spec.UpdatedBy = customUserRef(uid)
+	return spec, nil
+}
+
+// customDatasetUpdateQuery returns the [typeOf] dataset type UPDATE statement on behalf
+// of the [uid] user, RETURNING it's [id], [created_at], [created_by] and [updated_at].
+func customDatasetUpdateQuery(uid int64, typeOf customrel.DatasetDescriptor) statement {
+	var (
+		spec    = typeOf.ProtoDescriptor()
+		dir, dn = customDatasetPath(typeOf.Path())
+	)
+	return statement{
+		Query: psql.
+			Update(sqlident{schemaCustom, tableType}.String()).
+			Set(columnVer, sq.Expr(columnVer+" + 1")).
+			Set(columnTypeTitle, sq.Expr(":title")).
+			Set(columnTypeUsage, sq.Expr(":usage")).
+			Set(CustomSqlIdentifier(columnTypeFieldPrimary), sq.Expr(":primary")).
+			Set(CustomSqlIdentifier(columnTypeFieldDisplay), sq.Expr(":display")).
+			Set(columnUpdatedAt, sq.Expr("LOCALTIMESTAMP")).
+			Set(columnUpdatedBy, sq.Expr(":"+paramTypeUid)).
+			Where(fmt.Sprintf("%s = :%s", columnDc, paramTypeDc)).
+			Where(fmt.Sprintf("COALESCE(%s,'') = :%s", columnTypeDir, paramTypeDir)).
+			Where(fmt.Sprintf("%s = :%s", columnTypeName, paramTypeName)).
+			Suffix(fmt.Sprintf(
+				"RETURNING %s, %s, %s, %s",
+				columnTypeId, columnCreatedAt, columnCreatedBy, columnUpdatedAt,
+			)),
+		Params: map[string]any{
+			paramTypeDc:   typeOf.Dc(),
+			paramTypeUid:  customUserId(uid),
+			paramTypeDir:  dir,
+			paramTypeName: dn,
+			"title":       spec.GetName(),
+			"usage":       customTextValue(spec.GetAbout()),
+			"primary":     typeOf.Primary().Name(),
+			"display":     typeOf.Display().Name(),
+		},
+	}
+}
+
+// Delete [CUSTOM] dataset type(s) of the [dc] domain by relative [path](s).
+func (c *Catalog) Delete(ctx context.Context, dc int64, path ...string) (int64, error) {
+
+	if dc < 1 {
+		return 0, custom.RequestError(
+			"custom.dataset.readonly",
+			"custom: dataset type(s) are readonly",
+		)
+	}
+	if len(path) == 0 {
+		return 0, nil // nothing todo
+	}
+
+	list := make([]string, len(path))
+	for i, pkg := range path {
+		dir, dn := customDatasetPath(pkg)
+		list[i] = strings.ToLower(
+			strings.TrimPrefix(dir+"/"+dn, "/"),
+		)
+	}
+
+	deleteQ := customDatasetDeleteQuery(dc, list)
+	query, args, err := deleteQ.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = c.primary().QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, customSchemaError(err)
+	}
+
+	for _, pkg := range list {
+		_ = customreg.Invalidate(dc, pkg)
+	}
+	return count, nil
+}
+
+// customDatasetDeleteQuery returns the dataset type(s) of the [dc] domain
+// DELETE statement, by normalized [list] of path(s), with their field(s).
+// Selects the count of the type(s) deleted.
+func customDatasetDeleteQuery(dc int64, list []string) statement {
+	const (
+		typeAlias  = "t"
+		fieldAlias = "f"
+	)
+	deleteQ := statement{
+		Query: psql.
+			Select("count(*)").
+			From(typeAlias),
+		Params: map[string]any{
+			paramTypeDc: dc,
+			"path":      list,
+		},
+	}
+	deleteQ.CTE(CTE{
+		Name: typeAlias,
+		Query: psql.
+			Delete(sqlident{schemaCustom, tableType}.String()).
+			Where(fmt.Sprintf("%s = :%s", columnDc, paramTypeDc)).
+			Where(fmt.Sprintf(
+				"COALESCE((%s||'/'),'')||%s = ANY(:path)",
+				columnTypeDir, columnTypeName,
+			)).
+			Suffix("RETURNING " + columnTypeId),
+	})
+	deleteQ.CTE(CTE{
+		Name: fieldAlias,
+		Query: psql.
+			Delete(sqlident{schemaCustom, tableField}.String()).
+			Where(fmt.Sprintf(
+				"%s IN (SELECT %s FROM %s)",
+				columnFieldOf, columnTypeId, typeAlias,
+			)),
+	})
+	return deleteQ
+}
+
+// customFieldInsert writes [typeOf] dataset fields structure of the [id] type.
+func customFieldInsert(ctx context.Context, tx pgx.Tx, id int64, typeOf customrel.DatasetDescriptor) error {
+	insertQ, err := customFieldInsertQuery(id, typeOf)
+	if err != nil {
+		return err
+	}
+	query, args, err := insertQ.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+// customFieldInsertQuery returns the [typeOf] dataset field(s) INSERT statement of the type [id].
+func customFieldInsertQuery(id int64, typeOf customrel.DatasetDescriptor) (*statement, error) {
+
+	insertQ := psql.
+		Insert(sqlident{schemaCustom, tableField}.String()).
+		Columns(
+			columnFieldOf, columnFieldNum, columnFieldName,
+			columnFieldTitle, columnFieldUsage,
+			columnFieldTypeKind, columnFieldTypeList,
+			columnFieldTypeLookup, columnFieldTypeSpec,
+			CustomSqlIdentifier(columnFieldDataDefault),
+			columnFieldDataAlways,
+			columnFieldIsReadonly, columnFieldIsRequired,
+			columnFieldIsDisabled, columnFieldIsHidden,
+		)
+
+	params := map[string]any{
+		paramTypeId: id,
+		paramTypeDc: typeOf.Dc(),
+	}
+	for i, fd := range typeOf.ProtoDescriptor().GetFields() {
+		var (
+			num   = strconv.Itoa(i + 1)
+			param = func(name string, value any) sq.Sqlizer {
+				name = "f" + num + name
+				params[name] = value
+				return sq.Expr(":" + name)
+			}
+			kind, list, spec, ref, err = customFieldType(fd)
+			vdata                      = fd.GetDefault()
+			always                     = (vdata == nil && fd.GetAlways() != nil)
+		)
+		if err != nil {
+			return nil, err
+		}
+		if always {
+			vdata = fd.GetAlways()
+		}
+		value, err := customProtojsonValue(vdata)
+		if err != nil {
+			return nil, err
+		}
+		lookup := sq.Expr("NULL")
+		if ref != "" {
+			// resolve lookup dataset reference ; [ CUSTOM ] -or- [ GLOBAL ]
+			lookup = sq.Expr(fmt.Sprintf(
+				"(SELECT %[1]s FROM %[2]s WHERE COALESCE(%[3]s,:%[4]s) = :%[4]s"+
+					" AND COALESCE((%[5]s||'/'),'')||%[6]s = lower(:%[7]s)"+
+					" ORDER BY %[3]s NULLS LAST LIMIT 1)",
+				columnTypeId, sqlident{schemaCustom, tableType},
+				columnDc, paramTypeDc,
+				columnTypeDir, columnTypeName,
+				"f"+num+"rel",
+			))
+			params["f"+num+"rel"] = ref
+		}
+		insertQ = insertQ.Values(
+			sq.Expr(":"+paramTypeId),
+			param("num", (i+1)),
+			param("id", fd.GetId()),
+			param("title", fd.GetName()),
+			param("usage", customTextValue(fd.GetHint())),
+			param("kind", kind),
+			param("list", customTextValue(list)),
+			lookup,
+			param("type", spec),
+			param("default", value),
+			param("always", always),
+			param("readonly", fd.GetReadonly()),
+			param("required", fd.GetRequired()),
+			param("disabled", fd.GetDisabled()),
+			param("hidden", fd.GetHidden()),
+		)
+	}
+
+	return &statement{
+		Query:  insertQ,
+		Params: params,
+	}, nil
+}
+
+// customFieldType returns [fd] field data type [kind], [list] element kind,
+// type [spec]ification as JSONB value and the lookup [ref]erence path, if any.
+func customFieldType(fd *custompb.Field) (kind, list string, spec any, ref string, err error) {
+	kind = fd.GetKind().String()
+	mtyp := fd.ProtoReflect()
+	oneof := mtyp.WhichOneof(
+		mtyp.Descriptor().Oneofs().ByName("type"),
+	)
+	if oneof == nil {
+		// [NOTE] data type, e.g.: bool, has no specification required !
+		return kind, "", nil, "", nil
+	}
+	if fd.GetKind() == datatypb.Kind_list {
+		list = string(oneof.Name())
+	}
+	data, err := protojson.MarshalOptions{
+		UseProtoNames: true,
+	}.Marshal(
+		mtyp.Get(oneof).Message().Interface(),
+	)
+	if err != nil {
+		return "", "", nil, "", err
+	}
+	spec = string(data)
+	if lookup := fd.GetLookup(); lookup != nil {
+		ref = lookup.GetPath()
+	}
+	return // kind, list, spec, ref, nil
+}
+
+// customProtojsonValue returns [v] as JSONB value, if any.
+func customProtojsonValue(v *structpb.Value) (any, error) {
+	if v == nil {
+		return nil, nil // NULL
+	}
+	data, err := protojson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// customTextValue returns NULL for empty [s] text value.
+func customTextValue(s string) any {
+	if s == "" {
+		return nil // NULL
+	}
+	return s
+}
+
+// customUserId returns NULL for invalid [uid] value.
+func customUserId(uid int64) any {
+	if uid < 1 {
+		return nil // NULL
+	}
+	return uid
+}
+
+// customUserRef returns [uid] user as a Lookup reference, if valid.
+func customUserRef(uid int64) *custompb.Lookup {
+	if uid < 1 {
+		return nil
+	}
+	return &custompb.Lookup{
+		Id: strconv.FormatInt(uid, 10),
+	}
+}
+
 type datasetQ struct {
 	datasetOptions
 	*query[*custompb.Dataset]
@@ -381,9 +875,7 @@ func customDatasetSelectQuery(ctx *datasetQ) error {
 				})
 			}
 		case "indices":
-			{
-				// TODO:
-			}
+			// never stored ; always empty ; see customDatasetCheck
 		case "readonly":
 			// core ; always ! [dc] related
 		case "extendable":
@@ -648,15 +1140,71 @@ func customDatasetSelectQuery(ctx *datasetQ) error {
 	// params["pdc"] = &pdc
 	// query = query.Where(view.expr)
 	query = ctx.datasetOptions.Apply(query, params)
+	// [NOTE] result item(s) ; NO sort, limit and cursor position applied
+	items := query
+
+	// [NOTE] ORDER BY [id] ; stable paging !
+	keys, err := newKeyset(&ctx.req)
+	if err != nil {
+		return err
+	}
+	keys.add(columnId, sqlident{left, columnId}.String(), false)
 
 	// ------- PAGING --------
 	if size := ctx.req.GetSize(); size > 0 {
+		// keyset ; cursor position
+		query, err = keys.apply(query, params)
+		if err != nil {
+			return err
+		}
+		ctx.keys = keys
+		plan = append(plan, func(*custompb.Dataset) sql.Scanner {
+			return keys.scan()
+		})
 		// OFFSET (page-1)*size -- omit same-sized previous page(s) from result
-		if page := ctx.req.GetPage(); page > 1 {
+		if page := ctx.req.GetPage(); page > 1 && keys.cursor == nil {
 			query = query.Offset((uint64)((page - 1) * (size)))
 		}
 		// LIMIT (size+1) -- to indicate whether there are more result entries
 		query = query.Limit((uint64)(size + 1))
+	} else {
+		query = query.OrderBy(keys.keys[0].expr)
+	}
+
+	// ------- TOTAL(s) -------
+	if total := newSearchTotal(&ctx.req); total != nil {
+		for _, name := range ctx.req.Facets {
+			var group string
+			switch name = strings.ToLower(name); name {
+			case "dir":
+				group = fmt.Sprintf("COALESCE(%s,'')", sqlident{left, columnTypeDir})
+			case "readonly":
+				group = fmt.Sprintf("(%s ISNULL)", sqlident{left, columnDc})
+			case "extendable":
+				group = fmt.Sprintf(
+					"(%[1]s.%[2]s ISNULL AND %[1]s.%[3]s)",
+					left, columnDc, columnTypeExtendable,
+				)
+			default:
+				return custom.RequestError(
+					"custom.dataset.facet.invalid",
+					"custom: dataset{%s} facet is not supported",
+					name,
+				)
+			}
+			total.facet(name, group, "")
+		}
+		var scan []sql.Scanner
+		query, scan, err = total.apply(query, items, table, keys.cursor == nil)
+		if err != nil {
+			return err
+		}
+		ctx.total = total
+		for _, col := range scan {
+			plan = append(plan, func(*custompb.Dataset) sql.Scanner {
+				return col
+			})
+		}
 	}
 
 	ctx.Query = query
@@ -780,6 +1328,20 @@ func customDatasetFetchRows(ctx *datasetQ, rows pgx.Rows, into *custompb.Dataset
 		return err
 	}
 
+	if total := ctx.total; total != nil {
+		into.Total = total.total
+		into.Estimated = (total.mode == store.TotalEstimate)
+		into.Facets = total.facets
+	}
+	if keys := ctx.keys; keys != nil {
+		if into.Next {
+			into.Cursor = keys.token()
+		}
+		if keys.before {
+			// [NOTE] fetched in reverse order
+			slices.Reverse(data)
+		}
+	}
 	if !into.Next && into.Page <= 1 {
 		// The first page with NO more results !
 		into.Page = 0 // Hide: NO paging !
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	custom "github.com/webitel/custom/data"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func TestCatalogStatements(t *testing.T) {
+	cities := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "cities",
+		Path:    "dictionaries/cities",
+		Name:    "Cities",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string, Required: true},
+			{Id: "near", Kind: datapb.Kind_lookup, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "dictionaries/cities"},
+			}},
+		},
+	})
+	if err := cities.Err(); err != nil {
+		t.Fatalf("DictionaryOf() error = %v", err)
+	}
+	fields, err := customFieldInsertQuery(5, cities)
+	if err != nil {
+		t.Fatalf("customFieldInsertQuery() error = %v", err)
+	}
+	tests := []struct {
+		name string
+		stmt statement
+		want string
+		args string
+	}{
+		{
+			"create", customDatasetInsertQuery(7, cities),
+			`INSERT INTO custom.dataset (dc,dir,scope,title,usage,"primary",display,created_at,created_by,updated_at,updated_by) ` +
+				`VALUES ($1,$2,$3,$4,$5,$6,$7,LOCALTIMESTAMP,$8,LOCALTIMESTAMP,$8) RETURNING id, created_at`,
+			`[1 dictionaries cities Cities <nil> id name 7]`,
+		},
+		{
+			"update", customDatasetUpdateQuery(7, cities),
+			`UPDATE custom.dataset SET ver = ver + 1, title = $1, usage = $2, "primary" = $3, display = $4, ` +
+				`updated_at = LOCALTIMESTAMP, updated_by = $5 WHERE dc = $6 AND COALESCE(dir,'') = $7 AND scope = $8 ` +
+				`RETURNING id, created_at, created_by, updated_at`,
+			`[Cities <nil> id name 7 1 dictionaries cities]`,
+		},
+		{
+			"delete", customDatasetDeleteQuery(1, []string{"dictionaries/cities"}),
+			`WITH t AS (DELETE FROM custom.dataset WHERE dc = $1 AND COALESCE((dir||'/'),'')||scope = ANY($2) RETURNING id), ` +
+				`f AS (DELETE FROM custom.field WHERE of IN (SELECT id FROM t))SELECT count(*) FROM t`,
+			`[1 [dictionaries/cities]]`,
+		},
+		{
+			"fields", *fields,
+			`INSERT INTO custom.field (of,num,name,title,usage,kind,list,rel,type,"default",always,readonly,required,disabled,hidden) VALUES ` +
+				`($1,$2,$3,$4,$5,$6,$7,NULL,$8,$9,$10,$11,$12,$13,$14),` +
+				`($1,$15,$16,$17,$18,$19,$20,NULL,$21,$22,$23,$24,$25,$26,$27),` +
+				`($1,$28,$29,$30,$31,$32,$33,(SELECT id FROM custom.dataset WHERE COALESCE(dc,$34) = $34 ` +
+				`AND COALESCE((dir||'/'),'')||scope = lower($35) ORDER BY dc NULLS LAST LIMIT 1),$36,$37,$38,$39,$40,$41,$42)`,
+			`[5 1 id  <nil> int64 <nil> <nil> <nil> false false false false false ` +
+				`2 name  <nil> string <nil> <nil> <nil> false false true false false ` +
+				`3 near  <nil> lookup <nil> 1 dictionaries/cities {"path":"dictionaries/cities"} <nil> false false false false false]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args, err := tt.stmt.ToSql()
+			if err != nil {
+				t.Fatalf("statement.ToSql() error = %v", err)
+			}
+			if query != tt.want {
+				t.Errorf("statement.ToSql() =\n%s\nwant:\n%s", query, tt.want)
+			}
+			if got := fmt.Sprint(args); got != tt.args {
+				t.Errorf("statement.ToSql() args = %s, want %s", got, tt.args)
+			}
+		})
+	}
+}
+
+func TestCatalogDatasetCheck(t *testing.T) {
+	spec := func(indices map[string]*custompb.Index) *custompb.Dataset {
+		return &custompb.Dataset{
+			Repo:    "cities",
+			Path:    "dictionaries/cities",
+			Primary: "id",
+			Display: "name",
+			Fields: []*custompb.Field{
+				{Id: "id", Kind: datapb.Kind_int64},
+				{Id: "name", Kind: datapb.Kind_string},
+			},
+			Indices: indices,
+		}
+	}
+	tests := []struct {
+		name string
+		spec *custompb.Dataset
+		want string // error id
+	}{
+		{
+			name: "plain",
+			spec: spec(nil),
+		},
+		{
+			name: "indices",
+			spec: spec(map[string]*custompb.Index{
+				"name": {Unique: true, Fields: []string{"name"}},
+			}),
+			want: "custom.dataset.indices.unsupported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := customDatasetCheck(custom.DictionaryOf(1, tt.spec))
+			got := ""
+			if re, is := err.(*custom.Error); is {
+				got = re.Id
+			} else if err != nil {
+				t.Fatalf("customDatasetCheck() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("customDatasetCheck() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
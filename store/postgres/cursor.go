@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	custom "github.com/webitel/custom/data"
+	"github.com/webitel/custom/store"
+)
+
+// keyset pagination of the search query result.
+//
+//	ORDER BY k1 [ASC|DESC], .. , pk
+//	WHERE (k1 > :k1) OR (k1 = :k1 AND k2 > :k2) OR ..
+//
+// The NULL key value(s) are ordered according to the PostgreSQL default:
+// NULLS LAST for the ASC and NULLS FIRST for the DESC order.
+type keyset struct {
+	keys   []keysetKey
+	cursor *store.Cursor // position, if any
+	before bool          // backward ; reverse order
+	row    []*string     // keys of the last row scanned
+}
+
+// keysetKey is the single sort key.
+type keysetKey struct {
+	name string // spec
+	expr string // sql
+	desc bool
+}
+
+// newKeyset returns the [req]uest keyset pagination.
+func newKeyset(req *store.SearchOptions) (*keyset, error) {
+	pos, before, err := req.GetCursor()
+	if err != nil {
+		return nil, err
+	}
+	return &keyset{cursor: pos, before: before}, nil
+}
+
+// add the [name]d sort key [expr]ession.
+func (c *keyset) add(name, expr string, desc bool) {
+	c.keys = append(c.keys, keysetKey{
+		name: name, expr: expr, desc: desc,
+	})
+}
+
+// spec returns the sort keys canonical form, e.g.: "-score,id".
+func (c *keyset) spec() string {
+	var text strings.Builder
+	for i, key := range c.keys {
+		if i > 0 {
+			text.WriteByte(',')
+		}
+		if key.desc {
+			text.WriteByte('-')
+		}
+		text.WriteString(key.name)
+	}
+	return text.String()
+}
+
+// apply the keyset ORDER BY, the cursor position predicate, if any,
+// and the keys column, to be scanned last ; see scan(..)
+func (c *keyset) apply(query SelectQ, params Parameters) (SelectQ, error) {
+	if pos := c.cursor; pos != nil {
+		if pos.Sort != c.spec() || len(pos.Keys) != len(c.keys) {
+			return query, custom.RequestError(
+				"custom.search.cursor.invalid",
+				"custom: search( cursor: %s ); sort( %s ) mismatch",
+				pos.String(), c.spec(),
+			)
+		}
+		query = query.Where(c.where(params))
+	}
+	columns := make([]string, len(c.keys))
+	for i, key := range c.keys {
+		order := "ASC"
+		if key.desc != c.before {
+			order = "DESC"
+		}
+		query = query.OrderBy(key.expr + " " + order)
+		columns[i] = "(" + key.expr + ")::::text"
+	}
+	return query.Column(
+		"json_build_array(" + strings.Join(columns, ",") + ")::::text",
+	), nil
+}
+
+// where returns the position predicate of the row(s) following the cursor.
+func (c *keyset) where(params Parameters) string {
+	var (
+		or  []string
+		and []string // equal ; preceding key(s)
+	)
+	for i, key := range c.keys {
+		var (
+			desc  = (key.desc != c.before)
+			value = c.cursor.Keys[i]
+			param string
+		)
+		if value != nil {
+			param = ":k" + strconv.Itoa(i+1)
+			params[param[1:]] = *value
+		}
+		// strictly follows ..
+		var next string
+		switch {
+		case value == nil && !desc:
+			next = "" // NULLS LAST ; nothing follows
+		case value == nil:
+			next = key.expr + " NOTNULL" // NULLS FIRST
+		case !desc:
+			next = fmt.Sprintf("(%s > %s OR %[1]s ISNULL)", key.expr, param)
+		default:
+			next = fmt.Sprintf("%s < %s", key.expr, param)
+		}
+		switch {
+		case next == "":
+			// skip
+		case len(and) == 0 && next[0] == '(':
+			or = append(or, next)
+		default:
+			or = append(or, "("+strings.Join(append(slices.Clip(and), next), " AND ")+")")
+		}
+		if value == nil {
+			and = append(and, key.expr+" ISNULL")
+		} else {
+			and = append(and, fmt.Sprintf("%s = %s", key.expr, param))
+		}
+	}
+	if len(or) == 0 {
+		return "false"
+	}
+	return "(" + strings.Join(or, " OR ") + ")"
+}
+
+// scan returns the keys column scanner of the current row.
+func (c *keyset) scan() sql.Scanner {
+	return ScanFunc(func(src any) error {
+		c.row = nil
+		text, _ := src.(string)
+		if data, is := src.([]byte); is {
+			text = string(data)
+		}
+		if text == "" {
+			return nil
+		}
+		return json.Unmarshal([]byte(text), &c.row)
+	})
+}
+
+// token returns the cursor of the last row scanned.
+func (c *keyset) token() string {
+	pos := store.Cursor{
+		Sort: c.spec(),
+		Keys: c.row,
+	}
+	return pos.String()
+}
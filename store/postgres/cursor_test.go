@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/webitel/custom/store"
+)
+
+func Test_keysetApply(t *testing.T) {
+	text := func(s string) *string { return &s }
+	pos := store.Cursor{
+		Sort: "-score,name,id",
+		Keys: []*string{text("10"), nil, text("7")},
+	}
+	tests := []struct {
+		req    store.SearchOptions
+		want   string
+		params Parameters
+	}{
+		{
+			req:  store.SearchOptions{},
+			want: "SELECT * FROM t ORDER BY e.score DESC, e.name ASC, e.id ASC",
+		},
+		{
+			req: store.SearchOptions{After: pos.String()},
+			want: "SELECT * FROM t WHERE ((e.score < :k1) OR (e.score = :k1 AND e.name ISNULL AND (e.id > :k3 OR e.id ISNULL))) " +
+				"ORDER BY e.score DESC, e.name ASC, e.id ASC",
+			params: Parameters{"k1": "10", "k3": "7"},
+		},
+		{
+			req: store.SearchOptions{Before: pos.String()},
+			want: "SELECT * FROM t WHERE ((e.score > :k1 OR e.score ISNULL) OR (e.score = :k1 AND e.name NOTNULL) OR (e.score = :k1 AND e.name ISNULL AND e.id < :k3)) " +
+				"ORDER BY e.score ASC, e.name DESC, e.id DESC",
+			params: Parameters{"k1": "10", "k3": "7"},
+		},
+	}
+	for _, tt := range tests {
+		keys, err := newKeyset(&tt.req)
+		if err != nil {
+			t.Fatalf("newKeyset() error = %v", err)
+		}
+		keys.add("score", "e.score", true)
+		keys.add("name", "e.name", false)
+		keys.add("id", "e.id", false)
+		params := Parameters{}
+		query, err := keys.apply(psql.Select("*").From("t"), params)
+		if err != nil {
+			t.Fatalf("keyset.apply() error = %v", err)
+		}
+		query = query.RemoveColumns().Column("*")
+		if got, _, _ := query.ToSql(); got != tt.want {
+			t.Errorf("keyset.apply() =\n%s\nwant:\n%s", got, tt.want)
+		}
+		for name, want := range tt.params {
+			if params[name] != want {
+				t.Errorf("keyset.apply() params[%s] = %v, want %v", name, params[name], want)
+			}
+		}
+		_ = keys.scan().Scan(`["5",null,"9"]`)
+		next, err := store.ParseCursor(keys.token())
+		if err != nil || next.Sort != pos.Sort || len(next.Keys) != 3 || next.Keys[1] != nil || *next.Keys[2] != "9" {
+			t.Errorf("keyset.token() = %v, %v", next, err)
+		}
+	}
+
+	keys, _ := newKeyset(&store.SearchOptions{After: pos.String()})
+	keys.add("id", "e.id", false)
+	if _, err := keys.apply(psql.Select("*").From("t"), Parameters{}); err == nil {
+		t.Errorf("keyset.apply(sort: id) error = nil, want custom.search.cursor.invalid")
+	}
+}
@@ -302,6 +302,15 @@ func customSchemaError(err error) error {
 					// "contacts: duplicate labels tag",
 					"custom: dataset( repo: ); name: duplicate", // +e.Detail,
 				)
+			default:
+				if strings.HasSuffix(e.ConstraintName, "_idx") {
+					// customIndexName(..) ; UNIQUE INDEX
+					// {detail:"Key (code)=(UA) already exists."}
+					err = custom.ConflictError(
+						"custom.dataset.index.unique.violation",
+						"custom: %s", e.Detail,
+					)
+				}
 				// case "contact_label_tag_unique":
 				// 	// {detail:"Key (contact_id, tag)=(33, VIP) already exists."}
 				// 	err = model.ConflictError(
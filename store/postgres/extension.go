@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -90,10 +91,16 @@ type ExtensionQueryBuilder interface {
 	// [fields]  ; SELECT ROW([rel].fields,..)
 	Columns(from SelectQ, rel string, fields ...string) (query SelectQ, scan func(RecordExtendable) sql.Scanner, err error)
 
-	// // Where extension AND filter(s) ...
-	// Where(from sq.SelectBuilder, rel string, filters map[string]any) (query sq.SelectBuilder, err error)
-	// // OrderBy extension fields ...
-	// OrderBy(from sq.SelectBuilder, rel string, sort ...string) (query sq.SelectBuilder, err error)
+	// [from]    ; SELECT .. FROM contacts AS [left]
+	// [rel]     ; LEFT JOIN custom.x1_contacts AS [rel] ON (left.id, left.dc) = (right.id, right.dc)
+	// [filter]  ; WHERE [rel].field = :param AND .. ; map[field]assert ; see fieldFilter
+	// [params]  ; the filter value(s) bound ; compile the [query] with BindNamed
+	Where(from SelectQ, rel string, filter map[string]any) (query SelectQ, params Parameters, err error)
+	// [from]    ; SELECT .. FROM contacts AS [left]
+	// [rel]     ; LEFT JOIN custom.x1_contacts AS [rel] ON (left.id, left.dc) = (right.id, right.dc)
+	// [sort]    ; ORDER BY [rel].field [ASC|DESC] ; e.g.: "field", "+field" -OR- "-field"
+	// [NOTE] the [LOOKUP] field(s) are sorted by the referenced record [display] name ; compile the [query] with BindNamed
+	OrderBy(from SelectQ, rel string, sort ...string) (query SelectQ, err error)
 
 	// [pkx]       ; [P]rimary [K]ey [V]alue ; Accept: [SQLizer] -OR- GoValue
 	// [data]      ; record changes to be saved !
@@ -175,6 +182,44 @@ func (ds *dataset) Join(from SelectQ, left string, table string, right string) (
 // [rel]     ; LEFT JOIN custom.x1_contacts AS [rel] ON (left.id, left.dc) = (right.id, right.dc)
 // [fields]  ; SELECT ROW([rel].fields,..)
 func (ds *dataset) Columns(from SelectQ, rel string, fieldsQ ...string) (query SelectQ, scan func(RecordExtendable) sql.Scanner, err error) {
+	var (
+		rtyp = ds.rtyp
+		plan dataScanFunc[*custom.Record]
+	)
+	from, plan, err = ds.selectRecord(from, rel, false, fieldsQ...)
+	if err != nil {
+		return // from, nil, err
+	}
+	scan = func(rec RecordExtendable) sql.Scanner {
+		return ScanFunc(func(src any) (err error) {
+			if src == nil {
+				return // NULL
+			}
+			row := custom.NewRecord(rtyp) // ext.NewRecord()
+			err = plan(row).Scan(src)
+			if err != nil {
+				// CAST [sql] TO [custom] types failed !
+				return err
+			}
+			// err = row.Err()
+			// if err != nil {
+			// 	// [custom] composite (extension) type values vilation !
+			// 	return err
+			// }
+			data := row.Proto()
+			rec.SetCustom(data)
+			return // err
+		})
+	}
+	return from, scan, nil
+}
+
+// selectRecord appends single ROW([rel].fields,..) column to the [from] query
+// and returns the plan to decode it's value into the *custom.Record.
+//
+// [named] indicates whether the result query will be compiled with BindNamed,
+// so the '::::' escape sequence(s) MUST be kept as is.
+func (ds *dataset) selectRecord(from SelectQ, rel string, named bool, fieldsQ ...string) (query SelectQ, plan dataScanFunc[*custom.Record], err error) {
 	// from, right = x.Join(from, left, right)
 
 	// ext := ds.rtyp.(customrel.ExtensionDescriptor)
@@ -247,9 +292,13 @@ func (ds *dataset) Columns(from SelectQ, rel string, fieldsQ ...string) (query S
 						ref.colpk,
 						sqlident{ref.alias, ref.table.dc},
 					))
+					// lookup.query filter(s) ; if any
+					for _, pred := range customLookupQuery(elem.(*custom.Lookup), ref.alias, rel, fields) {
+						ref.query = ref.query.Where(pred)
+					}
 					ref.query, ref.coldn = ref.table.dn(ref.query, ref.alias, nil)
 					// unescape: "::::"
-					for i, n := 0, len(ref.coldn); i < n; i++ {
+					for i, n := 0, len(ref.coldn); i < n && !named; i++ {
 						ref.coldn[i], _, _ = BindNamed(ref.coldn[i], nil)
 					}
 					ref.query = ref.query.Column(fmt.Sprintf(
@@ -290,16 +339,21 @@ func (ds *dataset) Columns(from SelectQ, rel string, fieldsQ ...string) (query S
 					// ref.table = customDatasetTable(rtyp.Dc(), ref.typof.Name())
 					ref.table = customDatasetTable(ref.typof)
 					ref.colpk = sqlident{ref.alias, CustomSqlIdentifier(ref.typof.Primary().Name())}
-					from = from.JoinClause(fmt.Sprintf(
+					join := fmt.Sprintf(
 						"LEFT JOIN %[1]s %[2]s ON %[3]s.%[4]s = %[5]s AND %[3]s.dc = %[2]s.%[6]s",
 						ref.table.rel.String(), ref.alias, // [RIGHT] custom.d$dc_$repo AS x$num
 						rel, CustomSqlIdentifier(fd.Name()), // [LEFT] x.$fd
 						ref.colpk, // [RIGHT] $repo.$pk
 						ref.table.dc,
-					))
+					)
+					// lookup.query filter(s) ; if any
+					for _, pred := range customLookupQuery(vtyp.(*custom.Lookup), ref.alias, rel, fields) {
+						join += " AND " + pred
+					}
+					from = from.JoinClause(join)
 					from, ref.coldn = ref.table.dn(from, ref.alias, nil)
 					// unescape: "::::"
-					for i, n := 0, len(ref.coldn); i < n; i++ {
+					for i, n := 0, len(ref.coldn); i < n && !named; i++ {
 						ref.coldn[i], _, _ = BindNamed(ref.coldn[i], nil)
 					}
 					// ROW(x_.%field)
@@ -335,7 +389,7 @@ func (ds *dataset) Columns(from SelectQ, rel string, fieldsQ ...string) (query S
 					if fd == display {
 						from, column = ds.table.dn(from, rel, nil)
 						// unescape: "::::"
-						for i, n := 0, len(column); i < n; i++ {
+						for i, n := 0, len(column); i < n && !named; i++ {
 							column[i], _, _ = BindNamed(column[i], nil)
 						}
 					}
@@ -402,28 +456,109 @@ func (ds *dataset) Columns(from SelectQ, rel string, fieldsQ ...string) (query S
 	// row.WriteByte(')')
 	fmt.Fprintf(&row, ") WHERE %s.%s NOTNULL)", rel, CustomSqlIdentifier(primary.Name())) // AS "custom"
 	from = from.Column(row.String())
-	scan = func(rec RecordExtendable) sql.Scanner {
-		return ScanFunc(func(src any) (err error) {
-			if src == nil {
-				return // NULL
-			}
-			row := custom.NewRecord(rtyp) // ext.NewRecord()
-			err = customRecordScanFlatRow(scanPlan)(row).Scan(src)
+	return from, customRecordScanFlatRow(scanPlan), nil
+}
+
+// field returns the extension [name]d field available for the [op]eration, e.g.: "filter", "sort".
+func (ds *dataset) field(op, name string) (customrel.FieldDescriptor, error) {
+	var (
+		rtyp = ds.rtyp
+		fd   = rtyp.Fields().ByName(name)
+	)
+	if fd != nil {
+		// [NOTE]: [primary] and [display] are hidden from the extension data view !
+		if _, extensionIs := rtyp.(customrel.ExtensionDescriptor); extensionIs &&
+			(fd == rtyp.Primary() || fd == rtyp.Display()) {
+			fd = nil
+		}
+	}
+	if fd == nil {
+		return nil, custom.RequestError(
+			"custom.extension."+op+".field.not_found",
+			"custom: extension( %s: %s ); no such field",
+			op, name,
+		)
+	}
+	return fd, nil
+}
+
+// [from]    ; SELECT .. FROM contacts AS [left]
+// [rel]     ; LEFT JOIN custom.x1_contacts AS [rel] ON (left.id, left.dc) = (right.id, right.dc)
+// [filter]  ; WHERE [rel].field = :param AND .. ; map[field]assert ; see fieldFilter
+func (ds *dataset) Where(from SelectQ, rel string, filter map[string]any) (query SelectQ, params Parameters, err error) {
+	if len(filter) == 0 {
+		return from, nil, nil
+	}
+	names := make([]string, 0, len(filter))
+	for name := range filter {
+		names = append(names, name)
+	}
+	// [NOTE] stable query ; parameter(s) order
+	slices.Sort(names)
+	where := fieldFilter{
+		rel:    rel,
+		scope:  "custom.extension",
+		prefix: rel + "_",
+		params: make(Parameters, len(filter)),
+	}
+	for _, name := range names {
+		fd, err := ds.field("filter", name)
+		if err != nil {
+			return from, nil, err
+		}
+		pred, err := where.where(fd, filter[name])
+		if err != nil {
+			return from, nil, err
+		}
+		from = from.Where(pred)
+	}
+	return from, where.params, nil
+}
+
+// [from]    ; SELECT .. FROM contacts AS [left]
+// [rel]     ; LEFT JOIN custom.x1_contacts AS [rel] ON (left.id, left.dc) = (right.id, right.dc)
+// [sort]    ; ORDER BY [rel].field [ASC|DESC] ; e.g.: "field", "+field" -OR- "-field"
+func (ds *dataset) OrderBy(from SelectQ, rel string, sort ...string) (query SelectQ, err error) {
+	var (
+		order string
+		uniq  = names{}
+	)
+	for _, spec := range sort {
+		if spec == "" {
+			continue
+		}
+		order = "ASC"
+		switch spec[0] {
+		case '-':
+			order = "DESC"
+			spec = spec[1:]
+		case '+':
+			spec = spec[1:]
+		}
+		fd, err := ds.field("sort", spec)
+		if err != nil {
+			return from, err
+		}
+		if !uniq.append(fd.Name()) {
+			continue // duplicate
+		}
+		column := sqlident{rel, CustomSqlIdentifier(fd.Name())}.String()
+		switch typeOf := fd.Type().(type) {
+		case *custom.Lookup:
+			// ORDER BY the referenced record [display] name
+			column, err = customLookupName(typeOf.Dictionary(), rel, column)
 			if err != nil {
-				// CAST [sql] TO [custom] types failed !
-				return err
+				return from, err
 			}
-			// err = row.Err()
-			// if err != nil {
-			// 	// [custom] composite (extension) type values vilation !
-			// 	return err
-			// }
-			data := row.Proto()
-			rec.SetCustom(data)
-			return // err
-		})
+		case *custom.String:
+			if typeOf.IgnoreCase() {
+				// case-insensitive ; see customIndexLower(..)
+				column = "lower(" + column + ")"
+			}
+		}
+		from = from.OrderBy(column + " " + order)
 	}
-	return from, scan, nil
+	return from, nil
 }
 
 // [oid]       ; [P]rimary [K]ey [V]alue ; Accept: [SQLizer] -OR- GoValue
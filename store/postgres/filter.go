@@ -0,0 +1,419 @@
+package postgres
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgtype"
+	custom "github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
+	"github.com/webitel/custom/store"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fieldFilter builds the type-aware field assertion predicate(s)
+// of the [rel] relation record(s), binding the value(s) as the named [params].
+//
+// The assertion value, per field kind:
+//
+//	nil                     ; field ISNULL
+//	STRING, RICHTEXT        ; equal, or [I]LIKE substring, if contains any of '*' or '?' wildcard(s)
+//	INT, UINT, FLOAT,
+//	DECIMAL, MONEY,
+//	DATETIME, DURATION      ; equal, or the { "min": .., "max": .. } inclusive range, either optional
+//	LOOKUP                  ; equal [id], or the { "id": .. } -OR- { "name": .. } of the referenced record
+//	LIST                    ; contains element, or the { "any": [..] } -OR- { "all": [..] } element(s) ; [..] means "any"
+//	                        ; the single [string] element with wildcard(s) matches any [I]LIKE substring element
+//	..                      ; equal
+type fieldFilter struct {
+	rel    string     // relation alias
+	scope  string     // error id scope, e.g.: "custom.extension"
+	prefix string     // parameter(s) name prefix
+	params Parameters // value(s) bound
+}
+
+// bind the [value] as the next named parameter.
+// Returns the parameter reference, e.g.: ":xf1".
+func (c *fieldFilter) bind(value any) string {
+	if c.params == nil {
+		c.params = make(Parameters)
+	}
+	for n := len(c.params) + 1; ; n++ {
+		param := c.prefix + "f" + strconv.Itoa(n)
+		if _, ok := c.params[param]; !ok {
+			c.params[param] = value
+			return ":" + param
+		}
+	}
+}
+
+// badValue error of the [fd] field assertion.
+func (c *fieldFilter) badValue(fd customrel.FieldDescriptor, err error) error {
+	return custom.RequestError(
+		c.scope+".filter.bad_value",
+		"custom: %s{%s} invalid filter value ; error: %v",
+		fd.Dataset().Path(), fd.Name(), err,
+	)
+}
+
+// value decodes the [assert] value of the [typeOf] field type and casts it to the sql value.
+// Returns nil for the NULL value.
+func (c *fieldFilter) value(fd customrel.FieldDescriptor, typeOf customrel.Type, assert any) (any, error) {
+	rv := typeOf.New()
+	if err := rv.Decode(assert); err != nil {
+		return nil, c.badValue(fd, err)
+	}
+	if customrel.IsNull(rv) {
+		return nil, nil
+	}
+	vs, err := CustomTypeSqlValue(typeOf, rv.Interface())
+	if err != nil {
+		return nil, c.badValue(fd, err)
+	}
+	return vs, nil
+}
+
+// where returns the [fd] field [assert]ion predicate.
+func (c *fieldFilter) where(fd customrel.FieldDescriptor, assert any) (pred string, err error) {
+	var (
+		typeOf = fd.Type()
+		column = sqlident{c.rel, CustomSqlIdentifier(fd.Name())}.String()
+	)
+	assert = fieldFilterValue(assert)
+	if assert == nil {
+		return column + " ISNULL", nil
+	}
+	switch fd.Kind() {
+	case customrel.LIST:
+		return c.whereList(fd, column, assert)
+	case customrel.LOOKUP:
+		if spec, is := assert.(map[string]any); is {
+			return c.whereLookup(fd, column, spec)
+		}
+	case customrel.STRING, customrel.RICHTEXT:
+		if text, is := assert.(string); is && customFilterIsSubstring(text) {
+			return fmt.Sprintf(
+				"%s ILIKE %s", column, c.bind(customFilterSubstringAssertion(text)),
+			), nil
+		}
+		if str, is := typeOf.(*custom.String); is && str.IgnoreCase() {
+			vs, err := c.value(fd, typeOf, assert)
+			if err != nil || vs == nil {
+				return column + " ISNULL", err
+			}
+			// case-insensitive ; see customIndexLower(..)
+			return fmt.Sprintf(
+				"lower(%s) = lower(%s)", column, c.bind(vs),
+			), nil
+		}
+	case customrel.INT, customrel.INT32, customrel.INT64,
+		customrel.UINT, customrel.UINT32, customrel.UINT64,
+		customrel.FLOAT, customrel.FLOAT32, customrel.FLOAT64,
+		customrel.DECIMAL, customrel.MONEY,
+		customrel.DATETIME, customrel.DURATION:
+		if spec, is := assert.(map[string]any); is {
+			return c.whereRange(fd, column, spec)
+		}
+	}
+	vs, err := c.value(fd, typeOf, assert)
+	if err != nil || vs == nil {
+		return column + " ISNULL", err
+	}
+	return fmt.Sprintf(
+		"%s = %s", column, c.bind(vs),
+	), nil
+}
+
+// whereRange returns the { "min": .., "max": .. } inclusive range predicate.
+func (c *fieldFilter) whereRange(fd customrel.FieldDescriptor, column string, spec map[string]any) (string, error) {
+	var pred []string
+	for _, op := range []struct{ key, cmp string }{
+		{"min", ">="}, {"max", "<="},
+	} {
+		assert, ok := spec[op.key]
+		if !ok {
+			continue
+		}
+		vs, err := c.value(fd, fd.Type(), fieldFilterValue(assert))
+		if err != nil {
+			return "", err
+		}
+		if vs == nil {
+			continue // unbounded
+		}
+		pred = append(pred, fmt.Sprintf(
+			"%s %s %s", column, op.cmp, c.bind(vs),
+		))
+	}
+	if err := fieldFilterKeys(spec, "min", "max"); err != nil {
+		return "", c.badValue(fd, err)
+	}
+	switch len(pred) {
+	case 0:
+		return column + " NOTNULL", nil
+	case 1:
+		return pred[0], nil
+	}
+	return "(" + pred[0] + " AND " + pred[1] + ")", nil
+}
+
+// whereLookup returns the { "id": .. } -OR- { "name": .. } referenced record predicate.
+func (c *fieldFilter) whereLookup(fd customrel.FieldDescriptor, column string, spec map[string]any) (string, error) {
+	if err := fieldFilterKeys(spec, "id", "name"); err != nil || len(spec) != 1 {
+		if err == nil {
+			err = fmt.Errorf("either { id } or { name } expected")
+		}
+		return "", c.badValue(fd, err)
+	}
+	if id, ok := spec["id"]; ok {
+		return c.where(fd, id)
+	}
+	name := fieldFilterValue(spec["name"])
+	text, is := name.(string)
+	if !is && name != nil {
+		return "", c.badValue(fd, fmt.Errorf("name: string expected ; got %T", name))
+	}
+	var (
+		typeOf = fd.Type().(*custom.Lookup).Dictionary()
+		table  = customDatasetTable(typeOf)
+		alias  = c.rel + "_ref" // [NOTE] distinct from the [rel] one
+		colpk  = sqlident{alias, CustomSqlIdentifier(typeOf.Primary().Name())}
+		coldn  sqlident
+		query  = psql.Select().From(fmt.Sprintf(
+			"%s %s", table.rel, alias,
+		))
+	)
+	query, coldn = table.dn(query, alias, nil)
+	query = query.Column(colpk.String()).Where(fmt.Sprintf(
+		"%s.%s = %s.%s", alias, table.dc, c.rel, columnDc,
+	))
+	switch {
+	case name == nil:
+		query = query.Where(coldn.String() + " ISNULL")
+	case customFilterIsSubstring(text):
+		query = query.Where(fmt.Sprintf(
+			"%s ILIKE %s", coldn, c.bind(customFilterSubstringAssertion(text)),
+		))
+	default:
+		query = query.Where(fmt.Sprintf(
+			"%s = %s", coldn, c.bind(text),
+		))
+	}
+	sql, _, err := query.ToSql()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s IN (%s)", column, sql), nil
+}
+
+// whereList returns the list element(s) assertion predicate.
+func (c *fieldFilter) whereList(fd customrel.FieldDescriptor, column string, assert any) (string, error) {
+	var (
+		elem = fd.Type().(*custom.List).Elem()
+		cmp  = "&&" // overlap ; any
+		list []any
+	)
+	switch spec := assert.(type) {
+	case []any:
+		list = spec
+	case map[string]any:
+		if err := fieldFilterKeys(spec, "any", "all"); err != nil || len(spec) != 1 {
+			if err == nil {
+				err = fmt.Errorf("either { any } or { all } expected")
+			}
+			return "", c.badValue(fd, err)
+		}
+		if vs, ok := spec["all"]; ok {
+			cmp = "@>" // contains ; all
+			assert = fieldFilterValue(vs)
+		} else {
+			assert = fieldFilterValue(spec["any"])
+		}
+		if vs, ok := assert.([]any); ok {
+			list = vs
+		} else {
+			list = []any{assert}
+		}
+	default:
+		// single element
+		switch elem.Kind() {
+		case customrel.STRING, customrel.RICHTEXT:
+			if text, is := assert.(string); is && customFilterIsSubstring(text) {
+				// contains element ; [I]LIKE substring
+				return fmt.Sprintf(
+					"EXISTS (SELECT 1 FROM unnest(%s) AS v WHERE v ILIKE %s)",
+					column, c.bind(customFilterSubstringAssertion(text)),
+				), nil
+			}
+		}
+		vs, err := c.value(fd, elem, assert)
+		if err != nil || vs == nil {
+			return column + " ISNULL", err
+		}
+		return fmt.Sprintf(
+			"%s = ANY(%s)", c.bind(vs), column,
+		), nil
+	}
+	values := make([]any, 0, len(list))
+	for _, assert := range list {
+		vs, err := c.value(fd, elem, fieldFilterValue(assert))
+		if err != nil {
+			return "", err
+		}
+		if vs == nil {
+			continue // never matches
+		}
+		values = append(values, vs)
+	}
+	if len(values) == 0 && cmp == "@>" {
+		// [NOTE] any list contains the empty one
+		return "", c.badValue(fd, fmt.Errorf("{ all } element(s) required"))
+	}
+	return fmt.Sprintf(
+		"%s %s %s", column, cmp, c.bind(pgtype.FlatArray[any](values)),
+	), nil
+}
+
+// fieldFilterValue unwraps the [assert] protobuf value, if so:
+// the string, object and list are returned as the Go value(s)
+// with the nested scalar(s) kept as is, for the field type(s) to decode.
+func fieldFilterValue(assert any) any {
+	switch vs := assert.(type) {
+	case *structpb.Value:
+		switch kind := vs.GetKind().(type) {
+		case nil, *structpb.Value_NullValue:
+			return nil
+		case *structpb.Value_StringValue:
+			return kind.StringValue
+		case *structpb.Value_StructValue:
+			return fieldFilterValue(kind.StructValue)
+		case *structpb.Value_ListValue:
+			return fieldFilterValue(kind.ListValue)
+		}
+	case *structpb.Struct:
+		if vs == nil {
+			return nil
+		}
+		spec := make(map[string]any, len(vs.GetFields()))
+		for key, v := range vs.GetFields() {
+			spec[key] = v
+		}
+		return spec
+	case *structpb.ListValue:
+		if vs == nil {
+			return nil
+		}
+		list := make([]any, len(vs.GetValues()))
+		for i, v := range vs.GetValues() {
+			list[i] = v
+		}
+		return list
+	}
+	return assert
+}
+
+// fieldFilterKeys checks the [spec] object has known [keys] only.
+func fieldFilterKeys(spec map[string]any, keys ...string) error {
+	for key := range spec {
+		if !slices.Contains(keys, key) {
+			return fmt.Errorf("{ %s } unknown ; expected any of %v", key, keys)
+		}
+	}
+	return nil
+}
+
+// compare returns the [fd] field [op]erator [assert]ion predicate.
+// The equality is type-aware ; see where(..)
+func (c *fieldFilter) compare(fd customrel.FieldDescriptor, op store.FilterOp, assert any) (string, error) {
+	column := sqlident{c.rel, CustomSqlIdentifier(fd.Name())}.String()
+	switch op {
+	case store.FilterSet:
+		if fd.Kind() == customrel.BOOL {
+			return column + " IS TRUE", nil
+		}
+		return column + " NOTNULL", nil
+	case store.FilterEQ:
+		return c.where(fd, assert)
+	case store.FilterNE:
+		if assert == nil {
+			return column + " NOTNULL", nil
+		}
+		pred, err := c.where(fd, assert)
+		if err != nil {
+			return "", err
+		}
+		// [NOTE] NULL is NOT equal to any value
+		return "(" + pred + ") IS NOT TRUE", nil
+	}
+	if !op.Ordered() || fd.Kind() == customrel.LIST {
+		return "", custom.RequestError(
+			c.scope+".filter.operator.invalid",
+			"custom: %s{%s} operator %q is not applicable",
+			fd.Dataset().Path(), fd.Name(), op,
+		)
+	}
+	vs, err := c.value(fd, fd.Type(), fieldFilterValue(assert))
+	if err != nil {
+		return "", err
+	}
+	if vs == nil {
+		return "false", nil // NULL is never ordered
+	}
+	return fmt.Sprintf(
+		"%s %s %s", column, op, c.bind(vs),
+	), nil
+}
+
+// expr compiles the filter [x]pression of the [typeOf] dataset into the predicate.
+func (c *fieldFilter) expr(typeOf customrel.DatasetDescriptor, x store.FilterExpr) (sq.Sqlizer, error) {
+	switch x := x.(type) {
+	case store.FilterAnd:
+		and := make(sq.And, 0, len(x))
+		for _, e := range x {
+			pred, err := c.expr(typeOf, e)
+			if err != nil {
+				return nil, err
+			}
+			and = append(and, pred)
+		}
+		return and, nil
+	case store.FilterOr:
+		or := make(sq.Or, 0, len(x))
+		for _, e := range x {
+			pred, err := c.expr(typeOf, e)
+			if err != nil {
+				return nil, err
+			}
+			or = append(or, pred)
+		}
+		return or, nil
+	case store.FilterNot:
+		pred, err := c.expr(typeOf, x.X)
+		if err != nil {
+			return nil, err
+		}
+		sql, args, err := pred.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		// [NOTE] NOT (NULL) is NOT true
+		return sq.Expr("("+sql+") IS NOT TRUE", args...), nil
+	case *store.FilterCond:
+		fd := typeOf.Fields().ByName(x.Field)
+		if fd == nil {
+			return nil, custom.RequestError(
+				c.scope+".filter.field.not_found",
+				"custom: %s{%s} no such field",
+				typeOf.Path(), x.Field,
+			)
+		}
+		pred, err := c.compare(fd, x.Op, x.Value)
+		if err != nil {
+			return nil, err
+		}
+		return sq.Expr(pred), nil
+	}
+	return nil, fmt.Errorf("custom: filter( %T ) expression not supported", x)
+}
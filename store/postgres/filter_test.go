@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	custom "github.com/webitel/custom/data"
+	"github.com/webitel/custom/store"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_datasetWhere(t *testing.T) {
+	tasks := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "tasks",
+		Path:    "dictionaries/tasks",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "score", Kind: datapb.Kind_int32},
+			{Id: "tags", Kind: datapb.Kind_list, Type: &custompb.Field_String_{
+				String_: &datapb.Text{},
+			}},
+			{Id: "owner", Kind: datapb.Kind_lookup, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "users"},
+			}},
+		},
+	})
+	ds, err := newDataset(nil, tasks)
+	if err != nil {
+		t.Fatalf("newDataset() error = %v", err)
+	}
+	tests := []struct {
+		filter map[string]any
+		want   string
+		params Parameters
+	}{
+		{
+			filter: map[string]any{"name": "sup*", "score": structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{"min": structpb.NewNumberValue(1), "max": structpb.NewStringValue("5")},
+			})},
+			want:   `SELECT * FROM t WHERE x.name ILIKE :x_f1 AND (x.score >= :x_f2 AND x.score <= :x_f3)`,
+			params: Parameters{"x_f1": "sup%", "x_f2": "1", "x_f3": "5"},
+		},
+		{
+			filter: map[string]any{"tags": map[string]any{"all": []any{"a", "b"}}, "score": nil},
+			want:   `SELECT * FROM t WHERE x.score ISNULL AND x.tags @> :x_f1`,
+		},
+		{
+			filter: map[string]any{"tags": "foo*"},
+			want:   `SELECT * FROM t WHERE EXISTS (SELECT 1 FROM unnest(x.tags) AS v WHERE v ILIKE :x_f1)`,
+			params: Parameters{"x_f1": "foo%"},
+		},
+		{
+			filter: map[string]any{"owner": map[string]any{"name": "adm*"}},
+			want: `SELECT * FROM t WHERE x.owner IN (SELECT x_ref.id FROM directory.wbt_user x_ref ` +
+				`WHERE x_ref.dc = x.dc AND COALESCE(x_ref.name,(x_ref.username)::::text,'[deleted]') ILIKE :x_f1)`,
+			params: Parameters{"x_f1": "adm%"},
+		},
+	}
+	for _, tt := range tests {
+		query, params, err := ds.Where(psql.Select("*").From("t"), "x", tt.filter)
+		if err != nil {
+			t.Fatalf("Where(%v) error = %v", tt.filter, err)
+		}
+		if got, _, _ := query.ToSql(); got != tt.want {
+			t.Errorf("Where(%v) =\n%s\nwant:\n%s", tt.filter, got, tt.want)
+		}
+		for name, want := range tt.params {
+			if fmt.Sprint(params[name]) != fmt.Sprint(want) {
+				t.Errorf("Where(%v) params[%s] = %#v, want %#v", tt.filter, name, params[name], want)
+			}
+		}
+	}
+	if _, _, err = ds.Where(psql.Select("*").From("t"), "x", map[string]any{"score": "many"}); err == nil {
+		t.Errorf("Where(score: many) error = nil, want custom.extension.filter.bad_value")
+	}
+
+	for _, all := range []any{[]any{}, []any{nil}} {
+		filter := map[string]any{"tags": map[string]any{"all": all}}
+		if _, _, err = ds.Where(psql.Select("*").From("t"), "x", filter); err == nil {
+			t.Errorf("Where(tags: { all: %v }) error = nil, want custom.extension.filter.bad_value", all)
+		}
+	}
+
+	// [NOTE] the referenced relation alias MUST NOT shadow the [rel] one
+	query, _, err := ds.Where(psql.Select("*").From("t"), aliasRecord, map[string]any{"owner": map[string]any{"name": "admin"}})
+	if err != nil {
+		t.Fatalf("Where(rel: e) error = %v", err)
+	}
+	want := `SELECT * FROM t WHERE e.owner IN (SELECT e_ref.id FROM directory.wbt_user e_ref ` +
+		`WHERE e_ref.dc = e.dc AND COALESCE(e_ref.name,(e_ref.username)::::text,'[deleted]') = :e_f1)`
+	if got, _, _ := query.ToSql(); got != want {
+		t.Errorf("Where(rel: e) =\n%s\nwant:\n%s", got, want)
+	}
+	query, err = ds.OrderBy(psql.Select("*").From("t"), aliasRecord, "owner")
+	if err != nil {
+		t.Fatalf("OrderBy(rel: e) error = %v", err)
+	}
+	want = `SELECT * FROM t ORDER BY ( SELECT COALESCE(e_ref.name,(e_ref.username)::::text,'[deleted]') ` +
+		`FROM directory.wbt_user e_ref WHERE e_ref.id = e.owner AND e_ref.dc = e.dc ) ASC`
+	if got, _, _ := query.ToSql(); got != want {
+		t.Errorf("OrderBy(rel: e) =\n%s\nwant:\n%s", got, want)
+	}
+
+	query, err = ds.OrderBy(psql.Select("*").From("t"), "x", "-score", "owner", "+score")
+	if err != nil {
+		t.Fatalf("OrderBy() error = %v", err)
+	}
+	want = `SELECT * FROM t ORDER BY x.score DESC, ( SELECT COALESCE(x_ref.name,(x_ref.username)::::text,'[deleted]') ` +
+		`FROM directory.wbt_user x_ref WHERE x_ref.id = x.owner AND x_ref.dc = x.dc ) ASC`
+	if got, _, _ := query.ToSql(); got != want {
+		t.Errorf("OrderBy() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func Test_fieldFilterExpr(t *testing.T) {
+	cities := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "cities",
+		Path:    "dictionaries/cities",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "population", Kind: datapb.Kind_int64},
+			{Id: "archived", Kind: datapb.Kind_bool},
+		},
+	})
+	expr, err := store.ParseFilter("name=Kyiv* and population>=100000 or not archived and name!=null")
+	if err != nil {
+		t.Fatalf("ParseFilter() error = %v", err)
+	}
+	where := fieldFilter{rel: "e", scope: "custom.record", prefix: "q"}
+	pred, err := where.expr(cities, expr)
+	if err != nil {
+		t.Fatalf("fieldFilter.expr() error = %v", err)
+	}
+	want := "((e.name ILIKE :qf1 AND e.population >= :qf2) OR ((e.archived IS TRUE) IS NOT TRUE AND e.name NOTNULL))"
+	if got, _, _ := pred.ToSql(); got != want {
+		t.Errorf("fieldFilter.expr() =\n%s\nwant:\n%s", got, want)
+	}
+	if got := fmt.Sprint(where.params); got != "map[qf1:Kyiv% qf2:100000]" {
+		t.Errorf("fieldFilter.expr() params = %s", got)
+	}
+}
@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	custom "github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
+)
+
+// customIndex state of the [CUSTOM] dataset table index.
+type customIndex struct {
+	Name    string   // index relation name
+	Unique  bool     // UNIQUE index ?
+	Fields  []string // key column(s), ordered
+	Include []string // non-key column(s), ordered
+}
+
+// equal reports whether [ix] index definition is the same as [to].
+func (ix *customIndex) equal(to *customIndex) bool {
+	return ix.Name == to.Name &&
+		ix.Unique == to.Unique &&
+		slices.Equal(ix.Fields, to.Fields) &&
+		slices.Equal(ix.Include, to.Include)
+}
+
+// [NAMEDATALEN]-1 ; https://www.postgresql.org/docs/current/sql-syntax-lexical.html#SQL-SYNTAX-IDENTIFIERS
+const maxIdentifierLength = 63
+
+// customIndexName returns the [table] index relation name.
+// e.g.: d1_cities_name_idx
+func customIndexName(table customTable, name string) string {
+	const suffix = "_idx"
+	dn := fmt.Sprintf(
+		"%s_%s", table.rel.Name(), strings.ToLower(name),
+	)
+	// [NOTE] PostgreSQL truncates longer names silently !
+	// Keep the suffix to be able to find it back ..
+	if max := maxIdentifierLength - len(suffix); len(dn) > max {
+		dn = dn[0:max]
+	}
+	return dn + suffix
+}
+
+// customIndexOf returns [rtyp] dataset index definition for given [ix] descriptor.
+func customIndexOf(rtyp customrel.DatasetDescriptor, ix customrel.IndexDescriptor) customIndex {
+	var (
+		fields  = rtyp.Fields()
+		columns = func(names []string, keys bool) []string {
+			cols := make([]string, 0, len(names))
+			for _, dn := range names {
+				// resolve: exact field name
+				if fd := fields.ByName(dn); fd != nil {
+					dn = fd.Name()
+					// case-insensitive key ; lower(column)
+					if str, is := fd.Type().(*custom.String); keys && is && str.IgnoreCase() {
+						dn = customIndexLower(dn)
+					}
+				}
+				cols = append(cols, dn)
+			}
+			return cols
+		}
+	)
+	return customIndex{
+		Name:    customIndexName(customDatasetTable(rtyp), ix.Name()),
+		Unique:  ix.IsUnique(),
+		Fields:  columns(ix.Fields(), true),
+		Include: columns(ix.Include(), false),
+	}
+}
+
+// customIndexLower returns lower(column) key expression,
+// as the pg_get_indexdef(..) reports it back.
+func customIndexLower(column string) string {
+	return "lower(" + CustomSqlIdentifier(column) + ")"
+}
+
+// customIndexCreate returns CREATE INDEX statement for the [table] relation.
+func customIndexCreate(table customTable, ix *customIndex) string {
+	var (
+		stmt    strings.Builder
+		columns = func(names []string) string {
+			cols := make([]string, len(names))
+			for i, dn := range names {
+				if strings.ContainsRune(dn, '(') {
+					// expression ; e.g.: lower(column)
+					cols[i] = dn
+					continue
+				}
+				cols[i] = CustomSqlIdentifier(dn)
+			}
+			return strings.Join(cols, ", ")
+		}
+	)
+	stmt.WriteString("CREATE ")
+	if ix.Unique {
+		stmt.WriteString("UNIQUE ")
+	}
+	fmt.Fprintf(&stmt,
+		"INDEX %s ON %s (%s)",
+		ix.Name, table.rel.String(), columns(ix.Fields),
+	)
+	if len(ix.Include) > 0 {
+		fmt.Fprintf(&stmt,
+			" INCLUDE (%s)", columns(ix.Include),
+		)
+	}
+	return stmt.String()
+}
+
+// customIndexDrop returns DROP INDEX statement for the [table] relation.
+func customIndexDrop(table customTable, ix *customIndex) string {
+	return fmt.Sprintf(
+		"DROP INDEX IF EXISTS %s.%s",
+		table.rel.Schema(), ix.Name,
+	)
+}
+
+// customIndexPlan returns DDL statements to reconcile [rtyp] dataset indices
+// with the [current] index(es) state of it's table relation.
+//
+// Index(es) been changed are dropped first and then created with a new definition.
+func customIndexPlan(rtyp customrel.DatasetDescriptor, current []customIndex) (plan []string) {
+	var (
+		table  = customDatasetTable(rtyp)
+		expect = make([]customIndex, 0, rtyp.Indices().Num())
+		create []string
+	)
+	rtyp.Indices().Range(func(ix customrel.IndexDescriptor) bool {
+		expect = append(expect, customIndexOf(rtyp, ix))
+		return true
+	})
+	for i := range current {
+		was := &current[i]
+		at := slices.IndexFunc(expect, func(ix customIndex) bool {
+			return ix.Name == was.Name
+		})
+		if at < 0 || !expect[at].equal(was) {
+			// obsolete -or- changed !
+			plan = append(plan, customIndexDrop(table, was))
+		}
+	}
+	for i := range expect {
+		now := &expect[i]
+		at := slices.IndexFunc(current, func(ix customIndex) bool {
+			return ix.Name == now.Name
+		})
+		if at < 0 || !current[at].equal(now) {
+			// new -or- changed !
+			create = append(create, customIndexCreate(table, now))
+		}
+	}
+	return append(plan, create...)
+}
+
+// customIndexQuery returns [table] relation index(es) state query,
+// excluding [primary] key, managed by the dataset itself.
+//
+// [NOTE] Only index(es) named as customIndexName(table, ..) are taken into account.
+func customIndexQuery(table customTable) (query string, args []any) {
+	const (
+		// pg_index.indkey is int2vector ; zero-based !
+		// expression key has zero attnum ; e.g.: lower(column)
+		queryIndex = `SELECT c.relname
+, x.indisunique
+, array(SELECT COALESCE(a.attname, pg_get_indexdef(x.indexrelid, k+1, true)) FROM generate_subscripts(x.indkey, 1) k
+	LEFT JOIN pg_attribute a ON a.attrelid = x.indrelid AND a.attnum = x.indkey[k]
+	WHERE k < x.indnkeyatts ORDER BY k)::text[]
+, array(SELECT a.attname FROM generate_subscripts(x.indkey, 1) k
+	JOIN pg_attribute a ON a.attrelid = x.indrelid AND a.attnum = x.indkey[k]
+	WHERE k >= x.indnkeyatts ORDER BY k)::text[]
+FROM pg_catalog.pg_index x
+JOIN pg_catalog.pg_class c ON c.oid = x.indexrelid
+JOIN pg_catalog.pg_class t ON t.oid = x.indrelid
+JOIN pg_catalog.pg_namespace n ON n.oid = t.relnamespace
+WHERE n.nspname = $1 AND t.relname = $2
+  AND NOT x.indisprimary AND c.relname LIKE $3
+ORDER BY c.relname`
+	)
+	like := customFilterSubstringAssertion(
+		table.rel.Name() + "_*_idx",
+	)
+	return queryIndex, []any{
+		table.rel.Schema(), table.rel.Name(), like,
+	}
+}
+
+// customIndexScan returns the table index(es) state
+// fetched from the customIndexQuery [rows].
+func customIndexScan(rows pgx.Rows) ([]customIndex, error) {
+	var (
+		state []customIndex
+		index customIndex
+	)
+	_, err := pgx.ForEachRow(rows, []any{
+		&index.Name, &index.Unique, &index.Fields, &index.Include,
+	}, func() error {
+		state = append(state, index)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// customIndexSync reconciles [rtyp] dataset table indices within given [tx]
+func customIndexSync(ctx context.Context, tx pgx.Tx, rtyp customrel.DatasetDescriptor) error {
+	table := customDatasetTable(rtyp)
+	query, args := customIndexQuery(table)
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	state, err := customIndexScan(rows)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range customIndexPlan(rtyp, state) {
+		_, err = tx.Exec(ctx, stmt)
+		if err != nil {
+			return customSchemaError(err)
+		}
+	}
+	return nil
+}
+
+// SyncIndices reconciles [CUSTOM] dataset table index(es)
+// with it's current indices specification.
+func (c *Catalog) SyncIndices(ctx context.Context, rtyp customrel.DatasetDescriptor) error {
+	if rtyp == nil || rtyp.Dc() < 1 {
+		return custom.RequestError(
+			"custom.dataset.readonly",
+			"custom: dataset indices are readonly",
+		)
+	}
+	if err := rtyp.Err(); err != nil {
+		return err
+	}
+	return pgx.BeginFunc(ctx, c.primary(), func(tx pgx.Tx) error {
+		return customIndexSync(ctx, tx, rtyp)
+	})
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+
+	custom "github.com/webitel/custom/data"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func Test_customIndexPlan(t *testing.T) {
+	cities := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "cities",
+		Path:    "dictionaries/cities",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "Code", Kind: datapb.Kind_string},
+			{Id: "country", Kind: datapb.Kind_string},
+		},
+		Indices: map[string]*custompb.Index{
+			"code": {Unique: true, Fields: []string{"code"}, Include: []string{"name"}},
+			"geo":  {Fields: []string{"country", "name"}},
+		},
+	})
+	if err := cities.Err(); err != nil {
+		t.Fatalf("dataset error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		current []customIndex
+		want    []string
+	}{
+		{
+			name: "create",
+			want: []string{
+				`CREATE UNIQUE INDEX d1_cities_code_idx ON custom.d1_cities ("Code") INCLUDE (name)`,
+				`CREATE INDEX d1_cities_geo_idx ON custom.d1_cities (country, name)`,
+			},
+		},
+		{
+			name: "actual",
+			current: []customIndex{
+				{Name: "d1_cities_code_idx", Unique: true, Fields: []string{"Code"}, Include: []string{"name"}},
+				{Name: "d1_cities_geo_idx", Fields: []string{"country", "name"}},
+			},
+			want: nil,
+		},
+		{
+			name: "reconcile",
+			current: []customIndex{
+				{Name: "d1_cities_code_idx", Fields: []string{"Code"}},
+				{Name: "d1_cities_geo_idx", Fields: []string{"country", "name"}},
+				{Name: "d1_cities_obsolete_idx", Fields: []string{"name"}},
+			},
+			want: []string{
+				`DROP INDEX IF EXISTS custom.d1_cities_code_idx`,
+				`DROP INDEX IF EXISTS custom.d1_cities_obsolete_idx`,
+				`CREATE UNIQUE INDEX d1_cities_code_idx ON custom.d1_cities ("Code") INCLUDE (name)`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := customIndexPlan(cities, tt.current)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("customIndexPlan() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_customIndexIgnoreCase(t *testing.T) {
+	users := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "users",
+		Path:    "dictionaries/users",
+		Primary: "id",
+		Display: "Email",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "Email", Kind: datapb.Kind_string, Type: &custompb.Field_String_{
+				String_: &datapb.Text{IgnoreCase: true, Preset: datapb.Text_email},
+			}},
+		},
+		Indices: map[string]*custompb.Index{
+			"email": {Unique: true, Fields: []string{"email"}},
+		},
+	})
+	want := []string{
+		`CREATE UNIQUE INDEX d1_users_email_idx ON custom.d1_users (lower("Email"))`,
+	}
+	if got := customIndexPlan(users, nil); !slices.Equal(got, want) {
+		t.Errorf("customIndexPlan() = %q, want %q", got, want)
+	}
+	current := []customIndex{
+		{Name: "d1_users_email_idx", Unique: true, Fields: []string{`lower("Email")`}},
+	}
+	if got := customIndexPlan(users, current); len(got) != 0 {
+		t.Errorf("customIndexPlan() = %q, want none", got)
+	}
+}
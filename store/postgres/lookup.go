@@ -0,0 +1,269 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	custom "github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
+	custompb "github.com/webitel/proto/gen/custom"
+)
+
+// Lookups resolver of the [CUSTOM] and [GLOBAL] dictionary record(s) references.
+type Lookups struct {
+	schema *Catalog
+}
+
+func NewLookups(dc ...*pgxpool.Pool) *Lookups {
+	return &Lookups{
+		schema: NewCatalog(dc...),
+	}
+}
+
+var _ custom.LookupResolver = (*Lookups)(nil)
+
+const (
+	// Parameter of the lookup [P]rimary [K]ey value(s)
+	paramLookupPk = "pk"
+	// Parameter of the lookup [D]omain [C]omponent
+	paramLookupDc = "dc"
+)
+
+// ResolveLookup finds the [typeOf] dictionary record(s) of the [dc] domain by the primary key [ids], in batch,
+// matching the [filter] field value(s), if any.
+func (c *Lookups) ResolveLookup(ctx context.Context, dc int64, typeOf customrel.DictionaryDescriptor, filter map[string]any, ids ...string) ([]*custompb.Lookup, error) {
+
+	stmt, err := customLookupStatement(dc, typeOf, filter, ids...)
+	if err != nil || stmt == nil {
+		return nil, err
+	}
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.schema.secondary().Query(ctx, sql, args...)
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+	defer rows.Close()
+
+	var (
+		list = make([]*custompb.Lookup, 0, len(ids))
+		path = typeOf.Path()
+	)
+	for rows.Next() {
+		var (
+			id   string
+			name *string
+		)
+		err = rows.Scan(&id, &name)
+		if err != nil {
+			return nil, customSchemaError(err)
+		}
+		ref := &custompb.Lookup{
+			Id: id, Type: path,
+		}
+		if name != nil {
+			ref.Name = *name
+		}
+		list = append(list, ref)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+	return list, nil
+}
+
+// customLookupStatement returns the [typeOf] dictionary record(s) of the [dc] domain
+// (id, name) query by the primary key [ids], matching the [filter] field value(s), if any.
+// This return (nil, nil) if there are no valid [ids] to be found.
+func customLookupStatement(dc int64, typeOf customrel.DictionaryDescriptor, filter map[string]any, ids ...string) (*statement, error) {
+
+	var (
+		table   = customDatasetTable(typeOf)
+		primary = typeOf.Primary()
+		keys    = make([]any, 0, len(ids))
+	)
+	for _, id := range ids {
+		rv := primary.Type().New()
+		if err := rv.Decode(id); err != nil || customrel.IsNull(rv) {
+			continue // invalid ; never found
+		}
+		pk, err := CustomTypeSqlValue(primary.Type(), rv.Interface())
+		if err != nil {
+			continue // invalid ; never found
+		}
+		keys = append(keys, pk)
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	var (
+		colpk = sqlident{aliasRecord, CustomSqlIdentifier(primary.Name())}
+		query = psql.Select().From(fmt.Sprintf(
+			"%s AS %s", table.rel.String(), aliasRecord,
+		))
+		coldn sqlident
+	)
+	query, coldn = table.dn(query, aliasRecord, nil)
+	query = query.
+		Column(fmt.Sprintf("(%s)::::text", colpk)).
+		Column(fmt.Sprintf("(%s)::::text", coldn)).
+		Where(fmt.Sprintf(
+			"%s.%s = :%s", aliasRecord, table.dc, paramLookupDc,
+		)).
+		Where(fmt.Sprintf(
+			"%s = ANY(:%s)", colpk, paramLookupPk,
+		))
+
+	stmt := &statement{
+		Query: query,
+		Params: map[string]any{
+			paramLookupDc: dc,
+			paramLookupPk: pgtype.FlatArray[any](keys),
+		},
+	}
+	// ------- FILTER(s) -------
+	names := make([]string, 0, len(filter))
+	for name := range filter {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		fd := typeOf.Fields().ByName(name)
+		if fd == nil {
+			return nil, custom.RequestError(
+				"custom.type.lookup.query.field.not_found",
+				"custom: lookup( path: %s ).query( %s ); no such field",
+				typeOf.Path(), name,
+			)
+		}
+		column := sqlident{aliasRecord, CustomSqlIdentifier(fd.Name())}
+		if filter[name] == nil {
+			query = query.Where(column.String() + " ISNULL")
+			continue // next filter
+		}
+		rv := fd.Type().New()
+		err := rv.Decode(filter[name])
+		var vs any
+		if err == nil {
+			vs, err = CustomTypeSqlValue(fd.Type(), rv.Interface())
+		}
+		if err != nil {
+			return nil, custom.RequestError(
+				"custom.type.lookup.query.bad_value",
+				"custom: lookup( path: %s ).query( %s ); invalid value ; error: %v",
+				typeOf.Path(), name, err,
+			)
+		}
+		param := "q" + strconv.Itoa(fd.Num())
+		stmt.Params[param] = vs
+		query = query.Where(fmt.Sprintf(
+			"%s = :%s", column, param,
+		))
+	}
+	stmt.Query = query
+	return stmt, nil
+}
+
+// ResolveNames fills in the [name] and [type] of all the [list] record(s) [LOOKUP] value(s),
+// in a single query per referenced dictionary ; see custom.ResolveLookupNames.
+func (c *Lookups) ResolveNames(ctx context.Context, list ...*custom.Record) error {
+	return custom.ResolveLookupNames(ctx, c, list...)
+}
+
+// customLookupName returns the [ref] dictionary record [display] name scalar subquery
+// referenced by the [rel] relation [column] value, of the same domain.
+func customLookupName(ref customrel.DictionaryDescriptor, rel, column string) (string, error) {
+	var (
+		table = customDatasetTable(ref)
+		alias = rel + "_ref" // [NOTE] distinct from the [rel] one
+		coldn sqlident
+		query = psql.Select().From(fmt.Sprintf(
+			"%s %s", table.rel, alias,
+		))
+	)
+	query, coldn = table.dn(query, alias, nil)
+	query = query.Column(coldn.String()).
+		Where(fmt.Sprintf(
+			"%s = %s", sqlident{alias, CustomSqlIdentifier(ref.Primary().Name())}, column,
+		)).
+		Where(fmt.Sprintf(
+			"%s.%s = %s.%s", alias, table.dc, rel, columnDc,
+		))
+	name, _, err := query.Prefix("(").Suffix(")").ToSql()
+	return name, err
+}
+
+// customLookupQuery returns the [ref] lookup Query filter predicate(s) of the referenced [right] relation,
+// with the template placeholder(s) referring to the [left] relation column(s) of the same record [fields].
+func customLookupQuery(ref *custom.Lookup, right, left string, fields customrel.FieldDescriptors) (pred []string) {
+	query := ref.Query()
+	rel := ref.Dictionary()
+	if len(query) == 0 || rel == nil {
+		return nil
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		fd := rel.Fields().ByName(name)
+		if fd == nil {
+			continue // see Lookup.Err()
+		}
+		var expr []string
+		custom.RangeTemplate(query[name], func(part string, param bool) bool {
+			if !param {
+				expr = append(expr, customLookupLiteral(part))
+				return true
+			}
+			if fd := fields.ByName(part); fd != nil {
+				part = fd.Name()
+			}
+			expr = append(expr, sqlident{left, CustomSqlIdentifier(part)}.String())
+			return true
+		})
+		var value string
+		switch len(expr) {
+		case 0:
+			value = "''" // empty
+		case 1:
+			value = expr[0]
+		default:
+			// [NOTE] concat(..) is [text] ; cast to the [fd] column type
+			value = fmt.Sprintf(
+				"(concat(%s))::::%s", strings.Join(expr, ","),
+				customColumnType(fd.Type()),
+			)
+		}
+		pred = append(pred, fmt.Sprintf(
+			"%s IS NOT DISTINCT FROM %s",
+			sqlident{right, CustomSqlIdentifier(fd.Name())}, value,
+		))
+	}
+	return pred
+}
+
+// customLookupLiteral returns the [text] as SQL literal, free of the special character(s):
+// ':' of the named parameter(s) and '?' of the positional placeholder(s).
+func customLookupLiteral(text string) string {
+	literal := customSqlLiteral(text)
+	if !strings.ContainsAny(text, ":?") {
+		return literal
+	}
+	literal = strings.NewReplacer(
+		":", "'||chr(58)||'",
+		"?", "'||chr(63)||'",
+	).Replace(literal)
+	return "(" + literal + ")"
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	custom "github.com/webitel/custom/data"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+func Test_customLookupQuery(t *testing.T) {
+	employees := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "employees",
+		Path:    "dictionaries/employees",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "Team", Kind: datapb.Kind_string},
+			{Id: "state", Kind: datapb.Kind_string},
+			{Id: "level", Kind: datapb.Kind_int32},
+			{Id: "manager", Kind: datapb.Kind_lookup, Type: &custompb.Field_Lookup{
+				Lookup: &datapb.Lookup{Path: "dictionaries/employees", Query: map[string]string{
+					"team":  "{team}",
+					"state": "active",
+					"name":  "{state}: ?",
+					"level": "1{level}",
+				}},
+			}},
+		},
+	})
+	ref := employees.Fields().ByName("manager").Type().(*custom.Lookup)
+	if err := ref.Err(); err != nil {
+		t.Fatalf("Lookup.Err() = %v", err)
+	}
+	want := []string{
+		`x5.level IS NOT DISTINCT FROM (concat('1',e.level))::::int4`,
+		`x5.name IS NOT DISTINCT FROM (concat(e.state,(''||chr(58)||' '||chr(63)||'')))::::text`,
+		`x5.state IS NOT DISTINCT FROM 'active'`,
+		`x5."Team" IS NOT DISTINCT FROM e."Team"`,
+	}
+	if got := customLookupQuery(ref, "x5", "e", employees.Fields()); !slices.Equal(got, want) {
+		t.Errorf("customLookupQuery() = %q, want %q", got, want)
+	}
+}
+
+func Test_customLookupStatement(t *testing.T) {
+	employees := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "employees",
+		Path:    "dictionaries/employees",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "Team", Kind: datapb.Kind_string},
+			{Id: "age", Kind: datapb.Kind_int32},
+		},
+	})
+	filter := map[string]any{
+		"Team": "core", "age": nil,
+	}
+	stmt, err := customLookupStatement(1, employees, filter, "7", "x", "12")
+	if err != nil {
+		t.Fatalf("customLookupStatement() error = %v", err)
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		t.Fatalf("customLookupStatement().ToSql() error = %v", err)
+	}
+	want := `SELECT (e.id)::text, (e.name)::text FROM custom.d1_employees AS e ` +
+		`WHERE e.dc = $1 AND e.id = ANY($2) AND e."Team" = $3 AND e.age ISNULL`
+	if query != want {
+		t.Errorf("customLookupStatement() =\n%s\nwant:\n%s", query, want)
+	}
+	// [NOTE] invalid key(s) are never found ; omitted
+	if got := fmt.Sprint(args); len(args) != 3 || got != "[1 [7 12] core]" {
+		t.Errorf("customLookupStatement() args = %s, want [1 [7 12] core]", got)
+	}
+	if _, is := args[1].(pgtype.FlatArray[any]); !is {
+		t.Errorf("customLookupStatement() args[1] = %T, want pgtype.FlatArray[any]", args[1])
+	}
+
+	if stmt, err = customLookupStatement(1, employees, nil, "x"); stmt != nil || err != nil {
+		t.Errorf("customLookupStatement(invalid) = (%v, %v), want (nil, nil)", stmt, err)
+	}
+	_, err = customLookupStatement(1, employees, map[string]any{"age": "old"}, "7")
+	var re *custom.Error
+	if !errors.As(err, &re) || re.Id != "custom.type.lookup.query.bad_value" {
+		t.Errorf("customLookupStatement(age: old) error = %v, want custom.type.lookup.query.bad_value", err)
+	}
+}
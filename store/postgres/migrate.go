@@ -0,0 +1,567 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	custom "github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// MigrationStep of the [CUSTOM] dataset table schema migration plan.
+type MigrationStep struct {
+	// Field name affected, if any.
+	Field string
+	// Query of the DDL statement to be executed.
+	Query string
+	// Destructive step drops the data permanently.
+	// e.g.: DROP TABLE, DROP COLUMN.
+	Destructive bool
+	// Lossy step MAY lose precision or fail to convert existing data.
+	// e.g.: ALTER COLUMN TYPE with narrowing cast, SET NOT NULL.
+	Lossy bool
+}
+
+// MigrationPlan is an ordered list of the DDL steps
+// to migrate [CUSTOM] dataset table from one version to another.
+type MigrationPlan []MigrationStep
+
+// IsDestructive reports whether the plan contains any destructive step.
+func (plan MigrationPlan) IsDestructive() bool {
+	for i := range plan {
+		if plan[i].Destructive {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLossy reports whether the plan contains any lossy step.
+func (plan MigrationPlan) IsLossy() bool {
+	for i := range plan {
+		if plan[i].Lossy {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateOptions of the [CUSTOM] dataset table migration.
+type MigrateOptions struct {
+	// DryRun builds the plan ONLY, without execution.
+	DryRun bool
+	// Destructive step(s) are allowed to be executed.
+	Destructive bool
+	// Lossy step(s) are allowed to be executed.
+	Lossy bool
+}
+
+// Migrate [CUSTOM] dataset table schema [from] one type version [to] another.
+//
+// The [from] nil version means the table does not exist yet.
+// The [to] nil version means the table to be dropped.
+//
+// Returns the plan of DDL steps, executed within single transaction, unless [opts.DryRun].
+func (c *Catalog) Migrate(ctx context.Context, from, to customrel.DatasetDescriptor, opts MigrateOptions) (MigrationPlan, error) {
+
+	var state *customTableState
+	if from != nil && to != nil && from.Dc() > 0 {
+		// [NOTE] existing table MAY differ from the [from] version ;
+		// e.g.: legacy timestamp column(s), index(es) are NOT stored with the type
+		table := customDatasetTable(from)
+		state = &customTableState{}
+		query, args := customColumnQuery(table)
+		rows, err := c.primary().Query(ctx, query, args...)
+		if err == nil {
+			state.columns, err = customColumnState(rows)
+		}
+		if err == nil {
+			query, args = customIndexQuery(table)
+			rows, err = c.primary().Query(ctx, query, args...)
+		}
+		if err == nil {
+			state.indices, err = customIndexScan(rows)
+		}
+		if err != nil {
+			return nil, customSchemaError(err)
+		}
+	}
+	plan, err := customMigrationPlan(from, to, state)
+	if err != nil || opts.DryRun || len(plan) == 0 {
+		return plan, err
+	}
+	if plan.IsDestructive() && !opts.Destructive {
+		return plan, custom.ConflictError(
+			"custom.dataset.migration.destructive",
+			"custom: dataset migration drops the data permanently",
+		)
+	}
+	if plan.IsLossy() && !opts.Lossy {
+		return plan, custom.ConflictError(
+			"custom.dataset.migration.lossy",
+			"custom: dataset migration may lose the data converted",
+		)
+	}
+	err = pgx.BeginFunc(ctx, c.primary(), func(tx pgx.Tx) error {
+		for _, step := range plan {
+			_, err := tx.Exec(ctx, step.Query)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return plan, customSchemaError(err)
+	}
+	return plan, nil
+}
+
+// CustomMigrationPlan returns an ordered list of the DDL steps
+// to migrate [CUSTOM] dataset table [from] one type version [to] another.
+func CustomMigrationPlan(from, to customrel.DatasetDescriptor) (plan MigrationPlan, err error) {
+	return customMigrationPlan(from, to, nil)
+}
+
+// customTableState of the existing dataset table, as is.
+type customTableState struct {
+	columns map[string]string // data type name(s) by column name ; see customColumnQuery
+	indices []customIndex     // see customIndexQuery
+}
+
+// customMigrationPlan returns [from] => [to] migration plan, taking the existing
+// table [state] into account, if known ; otherwise the [from] version is the state.
+func customMigrationPlan(from, to customrel.DatasetDescriptor, state *customTableState) (plan MigrationPlan, err error) {
+	for _, rtyp := range []customrel.DatasetDescriptor{from, to} {
+		if rtyp == nil {
+			continue
+		}
+		if rtyp.Dc() < 1 {
+			return nil, custom.RequestError(
+				"custom.dataset.readonly",
+				"custom: dataset( %s ) is readonly",
+				rtyp.Path(),
+			)
+		}
+		if err = rtyp.Err(); err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case from == nil && to == nil:
+		return nil, nil // nothing todo
+	case from == nil:
+		return customTableCreate(to), nil
+	case to == nil:
+		return customTableDrop(from), nil
+	}
+	if from.Dc() != to.Dc() || !strings.EqualFold(from.Path(), to.Path()) {
+		return nil, custom.RequestError(
+			"custom.dataset.migration.invalid",
+			"custom: dataset( %s ) cannot migrate to ( %s )",
+			from.Path(), to.Path(),
+		)
+	}
+	if from.Primary().Name() != to.Primary().Name() ||
+		customColumnType(from.Primary().Type()) != customColumnType(to.Primary().Type()) {
+		return nil, custom.RequestError(
+			"custom.dataset.primary.immutable",
+			"custom: dataset( %s ).primary( %s ); cannot be changed",
+			to.Path(), from.Primary().Name(),
+		)
+	}
+
+	var (
+		table = customDatasetTable(to)
+		alter = func(fd customrel.FieldDescriptor, format string, args ...any) *MigrationStep {
+			plan = append(plan, MigrationStep{
+				Field: fd.Name(),
+				Query: fmt.Sprintf(
+					"ALTER TABLE %s %s", table.rel, fmt.Sprintf(format, args...),
+				),
+			})
+			return &plan[len(plan)-1]
+		}
+		columns map[string]string
+		current = customIndexState(from)
+	)
+	if state != nil {
+		columns, current = state.columns, state.indices
+	}
+	indices := customIndexPlan(to, current)
+
+	// [1] DROP INDEX ; obsolete -or- changed
+	for _, stmt := range indices {
+		if strings.HasPrefix(stmt, "DROP ") {
+			plan = append(plan, MigrationStep{Query: stmt})
+		}
+	}
+	// [2] DROP COLUMN ; obsolete
+	from.Fields().Range(func(was customrel.FieldDescriptor) bool {
+		if to.Fields().ByName(was.Name()) == nil {
+			alter(was, "DROP COLUMN %s", CustomSqlIdentifier(was.Name())).Destructive = true
+		}
+		return true
+	})
+	// [3] ALTER COLUMN ; changed
+	to.Fields().Range(func(now customrel.FieldDescriptor) bool {
+		was := from.Fields().ByName(now.Name())
+		if was == nil {
+			return true // [4] ADD COLUMN
+		}
+		var (
+			column   = CustomSqlIdentifier(now.Name())
+			vdata, _ = customColumnDefault(was)
+			value, _ = customColumnDefault(now)
+		)
+		if cast, lossy := customColumnCast(was.Type(), now.Type(), column, columns[now.Name()]); cast != "" {
+			if vdata != "" {
+				// [NOTE] DEFAULT of the previous type MAY fail to cast !
+				alter(now, "ALTER COLUMN %s DROP DEFAULT", column)
+				vdata = ""
+			}
+			alter(now, "ALTER COLUMN %s TYPE %s USING %s",
+				column, customColumnType(now.Type()), cast,
+			).Lossy = lossy
+		}
+		if vdata != value {
+			if value == "" {
+				alter(now, "ALTER COLUMN %s DROP DEFAULT", column)
+			} else {
+				alter(now, "ALTER COLUMN %s SET DEFAULT %s", column, value)
+			}
+		}
+		if was.IsRequired() != now.IsRequired() && !now.IsPrimary() {
+			if now.IsRequired() {
+				// [NOTE] fails on existing NULL value(s) !
+				alter(now, "ALTER COLUMN %s SET NOT NULL", column).Lossy = true
+			} else {
+				alter(now, "ALTER COLUMN %s DROP NOT NULL", column)
+			}
+		}
+		return true
+	})
+	// [4] ADD COLUMN ; new
+	to.Fields().Range(func(now customrel.FieldDescriptor) bool {
+		if from.Fields().ByName(now.Name()) == nil {
+			step := alter(now, "ADD COLUMN %s", customColumnDefinition(now))
+			// [NOTE] fails on existing row(s) without DEFAULT !
+			_, dflt := customColumnDefault(now)
+			step.Lossy = (now.IsRequired() && !dflt)
+		}
+		return true
+	})
+	// [5] CREATE INDEX ; new -or- changed
+	for _, stmt := range indices {
+		if strings.HasPrefix(stmt, "CREATE ") {
+			plan = append(plan, MigrationStep{Query: stmt})
+		}
+	}
+	return plan, nil
+}
+
+// customTableCreate returns [rtyp] dataset table creation plan.
+func customTableCreate(rtyp customrel.DatasetDescriptor) (plan MigrationPlan) {
+	var (
+		table   = customDatasetTable(rtyp)
+		primary = rtyp.Primary()
+		columns = []string{
+			columnDc + " int8 NOT NULL",
+			columnVer + " int4 NOT NULL DEFAULT 0",
+		}
+	)
+	rtyp.Fields().Range(func(fd customrel.FieldDescriptor) bool {
+		columns = append(columns, customColumnDefinition(fd))
+		return true
+	})
+	columns = append(columns, fmt.Sprintf(
+		"PRIMARY KEY (%s, %s)", columnDc,
+		CustomSqlIdentifier(primary.Name()),
+	))
+	plan = append(plan, MigrationStep{
+		Query: fmt.Sprintf(
+			"CREATE TABLE %s (%s)",
+			table.rel, strings.Join(columns, ", "),
+		),
+	})
+	for _, stmt := range customIndexPlan(rtyp, nil) {
+		plan = append(plan, MigrationStep{Query: stmt})
+	}
+	return plan
+}
+
+// customTableDrop returns [rtyp] dataset table removal plan.
+func customTableDrop(rtyp customrel.DatasetDescriptor) MigrationPlan {
+	return MigrationPlan{{
+		Query: fmt.Sprintf(
+			"DROP TABLE IF EXISTS %s",
+			customDatasetTable(rtyp).rel,
+		),
+		Destructive: true,
+	}}
+}
+
+// customIndexState returns [rtyp] dataset indices as it's table state.
+func customIndexState(rtyp customrel.DatasetDescriptor) []customIndex {
+	state := make([]customIndex, 0, rtyp.Indices().Num())
+	rtyp.Indices().Range(func(ix customrel.IndexDescriptor) bool {
+		state = append(state, customIndexOf(rtyp, ix))
+		return true
+	})
+	return state
+}
+
+// customColumnType returns SQL data type syntax of the [typ] column.
+// e.g.: int8, text[], timestamp
+func customColumnType(typ customrel.Type) string {
+	name := customTypeName(typ)
+	elem := typ
+	if list, is := typ.(*custom.List); is {
+		elem = list.Elem()
+	}
+	switch dt := elem.(type) {
+	case *custom.Decimal:
+		// numeric(precision, scale)
+		if dt.Precision() > 0 {
+			name = fmt.Sprintf("%s(%d,%d)", name, dt.Precision(), dt.Scale())
+		}
+	case *custom.DateTime:
+		// time(precision) | timestamptz(precision)
+		if p := customDateTimePrecision(dt); p >= 0 && dt.Part() != datapb.Datetime_date {
+			name = fmt.Sprintf("%s(%d)", name, p)
+		}
+	}
+	if strings.HasPrefix(name, "_") {
+		// array
+		return name[1:] + "[]"
+	}
+	return name
+}
+
+// customDateTimePrecision returns the fractional seconds digits
+// of the time(p) -or- timestamptz(p) column, if any. Negative means default.
+//
+// [NOTE] Default [ms] precision has no modifier, to keep existing columns as is ;
+// the value is truncated by the application. PostgreSQL precision is up to [mc].
+func customDateTimePrecision(dt *custom.DateTime) int {
+	switch pres := dt.Precision(); {
+	case pres == time.Millisecond:
+		return -1 // default
+	case pres >= time.Second:
+		return 0
+	}
+	return 6 // [mc]|[ns]
+}
+
+// customColumnDefinition returns [fd] column definition
+// for CREATE TABLE -or- ADD COLUMN statement.
+func customColumnDefinition(fd customrel.FieldDescriptor) string {
+	var (
+		typ = fd.Type()
+		def = []string{
+			CustomSqlIdentifier(fd.Name()),
+			customColumnType(typ),
+		}
+	)
+	if fd.IsPrimary() {
+		switch typ.Kind() {
+		case customrel.INT, customrel.INT32, customrel.INT64,
+			customrel.UINT, customrel.UINT32, customrel.UINT64:
+			// $(nextval) ; serial
+			def = append(def, "GENERATED BY DEFAULT AS IDENTITY")
+		}
+	} else if value, _ := customColumnDefault(fd); value != "" {
+		def = append(def, "DEFAULT", value)
+	}
+	if fd.IsPrimary() || fd.IsRequired() {
+		def = append(def, "NOT NULL")
+	}
+	return strings.Join(def, " ")
+}
+
+// customColumnDefault returns SQL [expr]ession of the [fd] column DEFAULT value.
+// The [ok] indicates whether DEFAULT value is guaranteed for new row(s).
+//
+// [NOTE] Value templates, except $(timestamp), are evaluated by the application.
+func customColumnDefault(fd customrel.FieldDescriptor) (expr string, ok bool) {
+	vs := fd.Descriptor().GetDefault()
+	if vs == nil {
+		return "", false
+	}
+	if text, is := vs.GetKind().(*structpb.Value_StringValue); is {
+		switch strings.TrimSpace(text.StringValue) {
+		case "$(timestamp)":
+			if customTypeName(fd.Type()) == "timestamptz" {
+				return "CURRENT_TIMESTAMP", true
+			}
+			return "LOCALTIMESTAMP", true
+		}
+		if strings.HasPrefix(text.StringValue, "$(") {
+			// [ APP ] evaluated
+			return "", true
+		}
+	}
+	typ := fd.Type()
+	if typ.Kind() == customrel.LIST || typ.Kind() == customrel.LOOKUP {
+		return "", false // [ APP ] evaluated
+	}
+	rv := typ.New()
+	if err := rv.Decode(vs); err != nil {
+		return "", false
+	}
+	v, err := CustomTypeSqlValue(typ, rv.Interface())
+	if err != nil || v == nil {
+		return "", false
+	}
+	var literal string
+	switch v := v.(type) {
+	case bool:
+		literal = strconv.FormatBool(v)
+	case int, int32, int64, uint, uint32, uint64:
+		literal = fmt.Sprintf("%d", v)
+	case float32:
+		literal = strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		literal = strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		literal = customSqlLiteral(v)
+	case time.Time:
+		literal = customSqlLiteral(v.Format("2006-01-02 15:04:05.999999"))
+	case time.Duration:
+		literal = customSqlLiteral(fmt.Sprintf("%d microseconds", v.Microseconds()))
+	case []byte:
+		literal = customSqlLiteral(fmt.Sprintf("\\x%x", v))
+	default:
+		return "", false
+	}
+	return literal + "::" + customColumnType(typ), true
+}
+
+// customSqlLiteral returns [s] as SQL string constant.
+func customSqlLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// safe (lossless) column type casts ; [from][to]
+var customColumnCastSafe = map[string][]string{
+	"bool":        {"text"},
+	"int4":        {"int8", "float8", "text"},
+	"int8":        {"text"},
+	"float4":      {"float8", "text"},
+	"float8":      {"text"},
+	"numeric":     {"text"},
+	"bytea":       {"text"},
+	"date":        {"timestamp", "text"},
+	"time":        {"text"},
+	"timestamp":   {"text"},
+	"timestamptz": {"text"},
+	"interval":    {"text"},
+}
+
+// customColumnCast returns the [column] USING cast expression
+// to convert [from] data type [to] another, if they differs.
+// The [udt] is the existing column data type name, if known, that takes
+// precedence over the [from] one ; e.g.: legacy timestamp of the full DATETIME.
+// The [lossy] indicates whether the data MAY be lost or fail to convert.
+func customColumnCast(from, to customrel.Type, column, udt string) (cast string, lossy bool) {
+	var (
+		was = customTypeName(from)
+		now = customTypeName(to)
+	)
+	if udt != "" {
+		was = udt // as is
+	}
+	if was == now {
+		if customColumnType(from) == customColumnType(to) {
+			return "", false // same
+		}
+		// type modifier changed, e.g.: numeric(precision, scale)
+		return fmt.Sprintf("%s::%s", column, customColumnType(to)), true
+	}
+	// [NOTE] timestamp [ without time zone ] value(s) are UTC
+	switch {
+	case now == "timestamptz" && (was == "timestamp" || was == "date"):
+		return fmt.Sprintf("(%s::timestamp AT TIME ZONE 'UTC')::%s", column, customColumnType(to)), false
+	case was == "timestamptz" && (now == "timestamp" || now == "date" || now == "time"):
+		return fmt.Sprintf("(%s AT TIME ZONE 'UTC')::%s", column, customColumnType(to)), now != "timestamp"
+	case now == "_timestamptz" && was == "_timestamp":
+		// [NOTE] no subquery to unnest within ALTER .. USING ; mark quoted element(s) as UTC
+		return fmt.Sprintf(
+			`regexp_replace(%s::text, '"([^"]+)"', '"\1+00"', 'g')::%s`,
+			column, customColumnType(to),
+		), false
+	}
+	var (
+		wasList = strings.HasPrefix(was, "_")
+		nowList = strings.HasPrefix(now, "_")
+		safe    = func(was, now string) bool {
+			for _, to := range customColumnCastSafe[was] {
+				if to == now {
+					return true
+				}
+			}
+			return false
+		}
+	)
+	switch {
+	case !wasList && nowList:
+		// scalar => array
+		elem := now[1:]
+		cast = fmt.Sprintf("ARRAY[%s::%s]", column, elem)
+		if was == elem {
+			cast = fmt.Sprintf("ARRAY[%s]", column)
+		}
+		return cast, !(was == elem || safe(was, elem))
+	case wasList && !nowList:
+		// array => scalar ; first element ONLY !
+		return fmt.Sprintf("(%s[1])::%s", column, now), true
+	case wasList && nowList:
+		// array => array
+		return fmt.Sprintf("%s::%s", column, customColumnType(to)), !safe(was[1:], now[1:])
+	}
+	return fmt.Sprintf("%s::%s", column, customColumnType(to)), !safe(was, now)
+}
+
+// customColumnQuery returns [table] relation column(s) data type state query.
+func customColumnQuery(table customTable) (query string, args []any) {
+	const (
+		// pg_type.typname of the array type has "_" prefix ; e.g.: _timestamp
+		queryColumn = `SELECT a.attname, t.typname
+FROM pg_catalog.pg_attribute a
+JOIN pg_catalog.pg_type t ON t.oid = a.atttypid
+JOIN pg_catalog.pg_class c ON c.oid = a.attrelid
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2
+  AND a.attnum > 0 AND NOT a.attisdropped`
+	)
+	return queryColumn, []any{
+		table.rel.Schema(), table.rel.Name(),
+	}
+}
+
+// customColumnState returns the column(s) data type name(s), by column name,
+// fetched from the customColumnQuery [rows] ; e.g.: {"born": "timestamp"}.
+// The attname is the field name as is ; see CustomSqlIdentifier.
+func customColumnState(rows pgx.Rows) (map[string]string, error) {
+	var (
+		state = make(map[string]string)
+		name  string
+		udt   string
+	)
+	_, err := pgx.ForEachRow(rows, []any{
+		&name, &udt,
+	}, func() error {
+		state[name] = udt
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
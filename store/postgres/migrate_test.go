@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+
+	custom "github.com/webitel/custom/data"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCustomMigrationPlan(t *testing.T) {
+	from := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "cities",
+		Path:    "dictionaries/cities",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string},
+			{Id: "size", Kind: datapb.Kind_int32},
+			{Id: "code", Kind: datapb.Kind_string},
+			{Id: "note", Kind: datapb.Kind_string},
+		},
+	})
+	to := custom.DictionaryOf(1, &custompb.Dataset{
+		Repo:    "cities",
+		Path:    "dictionaries/cities",
+		Primary: "id",
+		Display: "name",
+		Fields: []*custompb.Field{
+			{Id: "id", Kind: datapb.Kind_int64},
+			{Id: "name", Kind: datapb.Kind_string, Required: true},
+			{Id: "size", Kind: datapb.Kind_int64},
+			{Id: "code", Kind: datapb.Kind_int32},
+			{Id: "tags", Kind: datapb.Kind_list, Type: &custompb.Field_String_{String_: &datapb.Text{}}},
+			{Id: "live", Kind: datapb.Kind_bool, Value: &custompb.Field_Default{
+				Default: structpb.NewBoolValue(true),
+			}},
+		},
+		Indices: map[string]*custompb.Index{
+			"code": {Unique: true, Fields: []string{"code"}},
+		},
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		plan, err := CustomMigrationPlan(from, to)
+		if err != nil {
+			t.Fatalf("CustomMigrationPlan() error = %v", err)
+		}
+		want := []string{
+			`ALTER TABLE custom.d1_cities DROP COLUMN note`,
+			`ALTER TABLE custom.d1_cities ALTER COLUMN name SET NOT NULL`,
+			`ALTER TABLE custom.d1_cities ALTER COLUMN size TYPE int8 USING size::int8`,
+			`ALTER TABLE custom.d1_cities ALTER COLUMN code TYPE int4 USING code::int4`,
+			`ALTER TABLE custom.d1_cities ADD COLUMN tags text[]`,
+			`ALTER TABLE custom.d1_cities ADD COLUMN live bool DEFAULT true::bool`,
+			`CREATE UNIQUE INDEX d1_cities_code_idx ON custom.d1_cities (code)`,
+		}
+		got := make([]string, len(plan))
+		for i, step := range plan {
+			got[i] = step.Query
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("CustomMigrationPlan() = %q, want %q", got, want)
+		}
+		if !plan.IsDestructive() || !plan[0].Destructive {
+			t.Errorf("DROP COLUMN expected to be destructive")
+		}
+		if !plan[1].Lossy || plan[2].Lossy || !plan[3].Lossy {
+			t.Errorf("lossy steps detection failed")
+		}
+	})
+
+	t.Run("timestamptz", func(t *testing.T) {
+		// [NOTE] the full DATETIME field(s) of the legacy timestamp column(s)
+		typeOf := custom.DictionaryOf(1, &custompb.Dataset{
+			Repo:    "events",
+			Path:    "dictionaries/events",
+			Primary: "id",
+			Display: "id",
+			Fields: []*custompb.Field{
+				{Id: "id", Kind: datapb.Kind_int64},
+				{Id: "born", Kind: datapb.Kind_datetime, Type: &custompb.Field_Datetime{Datetime: &datapb.Datetime{}}},
+				{Id: "seen", Kind: datapb.Kind_list, Type: &custompb.Field_Datetime{Datetime: &datapb.Datetime{}}},
+				{Id: "when", Kind: datapb.Kind_datetime, Type: &custompb.Field_Datetime{Datetime: &datapb.Datetime{}}},
+			},
+		})
+		plan, err := customMigrationPlan(typeOf, typeOf, &customTableState{
+			columns: map[string]string{
+				"id": "int8", "born": "timestamp", "seen": "_timestamp", "when": "timestamptz",
+			},
+		})
+		if err != nil {
+			t.Fatalf("customMigrationPlan() error = %v", err)
+		}
+		want := []string{
+			`ALTER TABLE custom.d1_events ALTER COLUMN born TYPE timestamptz USING (born::timestamp AT TIME ZONE 'UTC')::timestamptz`,
+			`ALTER TABLE custom.d1_events ALTER COLUMN seen TYPE timestamptz[] USING regexp_replace(seen::text, '"([^"]+)"', '"\1+00"', 'g')::timestamptz[]`,
+		}
+		got := make([]string, len(plan))
+		for i, step := range plan {
+			got[i] = step.Query
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("customMigrationPlan() = %q, want %q", got, want)
+		}
+		if plan.IsLossy() {
+			t.Errorf("timestamp => timestamptz expected to be lossless")
+		}
+		// the column(s) state is unknown ; as is
+		if plan, _ = CustomMigrationPlan(typeOf, typeOf); len(plan) != 0 {
+			t.Errorf("CustomMigrationPlan() = %v, want none", plan)
+		}
+	})
+
+	t.Run("indices", func(t *testing.T) {
+		// [NOTE] the catalog type has NO indices ; the table state has
+		from := custom.DictionaryOf(1, &custompb.Dataset{
+			Repo:    "cities",
+			Path:    "dictionaries/cities",
+			Primary: "id",
+			Display: "name",
+			Fields:  to.ProtoDescriptor().GetFields(),
+		})
+		state := &customTableState{
+			indices: []customIndex{
+				{Name: "d1_cities_code_idx", Unique: true, Fields: []string{"code"}},
+				{Name: "d1_cities_name_idx", Fields: []string{"name"}},
+			},
+		}
+		plan, err := customMigrationPlan(from, to, state)
+		if err != nil {
+			t.Fatalf("customMigrationPlan() error = %v", err)
+		}
+		want := []string{
+			`DROP INDEX IF EXISTS custom.d1_cities_name_idx`,
+		}
+		got := make([]string, len(plan))
+		for i, step := range plan {
+			got[i] = step.Query
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("customMigrationPlan() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("create", func(t *testing.T) {
+		plan, err := CustomMigrationPlan(nil, from)
+		if err != nil {
+			t.Fatalf("CustomMigrationPlan() error = %v", err)
+		}
+		want := `CREATE TABLE custom.d1_cities (dc int8 NOT NULL, ver int4 NOT NULL DEFAULT 0, ` +
+			`id int8 GENERATED BY DEFAULT AS IDENTITY NOT NULL, name text, size int4, code text, note text, ` +
+			`PRIMARY KEY (dc, id))`
+		if len(plan) != 1 || plan[0].Query != want {
+			t.Fatalf("CustomMigrationPlan() = %v, want %q", plan, want)
+		}
+	})
+}
+
+func Test_customColumnType(t *testing.T) {
+	tests := []struct {
+		typ  custom.Type
+		want string
+	}{
+		{custom.DecimalAs(&datapb.Decimal{}), "numeric"},
+		{custom.DecimalAs(&datapb.Decimal{Precision: 10, Scale: 2}), "numeric(10,2)"},
+		{custom.ListAs(custom.MoneyAs(&datapb.Decimal{Currency: "USD", Precision: 12, Scale: 2})), "numeric(12,2)[]"},
+		{custom.DateTimeAs(&datapb.Datetime{}), "timestamptz"},
+		{custom.DateTimeAs(&datapb.Datetime{Stamp: datapb.Datetime_s}), "timestamptz(0)"},
+		{custom.DateTimeAs(&datapb.Datetime{Part: datapb.Datetime_date}), "date"},
+		{custom.DateTimeAs(&datapb.Datetime{Part: datapb.Datetime_time, Stamp: datapb.Datetime_ns}), "time(6)"},
+	}
+	for _, tt := range tests {
+		if got := customColumnType(tt.typ); got != tt.want {
+			t.Errorf("customColumnType(%s) = %q, want %q", tt.typ.Kind(), got, tt.want)
+		}
+	}
+}
@@ -12,8 +12,10 @@ type (
 )
 
 type query[TRow any] struct {
-	req  store.SearchOptions
-	plan dataScanPlan[TRow]
+	req   store.SearchOptions
+	plan  dataScanPlan[TRow]
+	keys  *keyset      // pagination ; if paged
+	total *searchTotal // count(s) ; if requested
 	statement
 }
 
@@ -1 +1,754 @@
 package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	custom "github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
+	"github.com/webitel/custom/store"
+)
+
+// Records store of the [CUSTOM] dictionaries data.
+type Records struct {
+	schema *Catalog
+}
+
+func NewRecords(dc ...*pgxpool.Pool) *Records {
+	return &Records{
+		schema: NewCatalog(dc...),
+	}
+}
+
+var _ store.Records = (*Records)(nil)
+
+const (
+	// Record(s) relation alias
+	aliasRecord = "e"
+	// Parameter of the [D]omain [C]omponent
+	paramRecordDc = "dc"
+	// Parameter of the [P]rimary [K]ey value(s)
+	paramRecordPk = "pk"
+)
+
+type recordQ struct {
+	*dataset
+	*query[*custom.Record]
+}
+
+// dataset returns [typeOf] dataset of the data records.
+// The readonly and [ GLOBAL ] dictionaries are readable ; see writable(..)
+func (c *Records) dataset(typeOf customrel.DictionaryDescriptor) (*dataset, error) {
+	if typeOf == nil {
+		return nil, custom.RequestError(
+			"custom.dictionary.type.required",
+			"custom: dictionary type required but missing",
+		)
+	}
+	if err := typeOf.Err(); err != nil {
+		return nil, err
+	}
+	return &dataset{
+		dc: c.schema, rtyp: typeOf,
+		table: customDatasetTable(typeOf),
+	}, nil
+}
+
+// writable asserts the [typeOf] dictionary records can be modified.
+// Rejects readonly and [ GLOBAL ] dictionaries.
+func (c *Records) writable(typeOf customrel.DictionaryDescriptor) error {
+	if typeOf != nil && (typeOf.IsReadonly() || typeOf.Dc() < 1) {
+		return custom.RequestError(
+			"custom.dictionary.readonly",
+			"custom: dictionary( %s ) is readonly",
+			typeOf.Path(),
+		)
+	}
+	return nil
+}
+
+func (c *Records) query(typeOf customrel.DictionaryDescriptor, req store.SearchOptions) (*recordQ, error) {
+	ds, err := c.dataset(typeOf)
+	if err != nil {
+		return nil, err
+	}
+	dc := typeOf.Dc()
+	if dc < 1 {
+		// [ GLOBAL ] dictionary records of the requested domain
+		if dc = req.Dc; dc < 1 {
+			return nil, custom.RequestError(
+				"custom.dictionary.domain.required",
+				"custom: dictionary( %s ) records domain required but missing",
+				typeOf.Path(),
+			)
+		}
+	}
+	ctx := &recordQ{
+		dataset: ds,
+		query:   newQuery[*custom.Record](),
+	}
+	ctx.req = req
+	ctx.Params[paramRecordDc] = dc
+	return ctx, nil
+}
+
+// selectFrom sets the [from] relation records projection query.
+func (ctx *recordQ) selectFrom(from string, fields ...string) error {
+	query := psql.Select().From(fmt.Sprintf(
+		"%s AS %s", from, aliasRecord,
+	))
+	query, scan, err := ctx.selectRecord(
+		query, aliasRecord, true, fields...,
+	)
+	if err != nil {
+		return err
+	}
+	ctx.Query = query
+	ctx.plan = append(ctx.plan, scan)
+	return nil
+}
+
+// returns the [P]rimary [K]ey field value, casted to it's sql value.
+func (ctx *recordQ) primaryKey(id any) (pk any, err error) {
+	var (
+		dataset = ctx.rtyp
+		primary = dataset.Primary()
+		rv      = primary.Type().New()
+	)
+	err = rv.Decode(id)
+	if err == nil && customrel.IsNull(rv) {
+		err = custom.RequestError(
+			"custom.record.id.required",
+			"custom: %s.%s record key required but missing",
+			dataset.Path(), primary.Name(),
+		)
+	}
+	if err != nil {
+		if _, is := err.(*custom.Error); !is {
+			err = custom.RequestError(
+				"custom.record.id.bad_value",
+				"custom: %s.%s( %v ) invalid record key ; error: %v",
+				dataset.Path(), primary.Name(), id, err,
+			)
+		}
+		return nil, err
+	}
+	return CustomTypeSqlValue(primary.Type(), rv.Interface())
+}
+
+// recordValue assigns [rec] field value as a named query parameter.
+func (ctx *recordQ) recordValue(fd customrel.FieldDescriptor, vs any) (param string, err error) {
+	// Cast to (sql.Value) ..
+	vs, err = CustomTypeSqlValue(fd.Type(), vs)
+	if err != nil {
+		return "", custom.RequestError(
+			"custom.record.field.bad_value",
+			"custom: %s.%s invalid value ; error: %v",
+			ctx.rtyp.Path(), fd.Name(), err,
+		)
+	}
+	param = "r1c" + strconv.Itoa(fd.Num()) // fd.Name()
+	ctx.Params[param] = vs
+	return param, nil
+}
+
+// checks whether the [rec]ord is of the [ctx] dataset type.
+func (ctx *recordQ) checkRecord(rec *custom.Record) error {
+	if rec == nil {
+		return custom.RequestError(
+			"custom.record.data.required",
+			"custom: %s record data required but missing",
+			ctx.rtyp.Path(),
+		)
+	}
+	rtyp := rec.Dataset()
+	if rtyp != ctx.rtyp && (rtyp.Dc() != ctx.rtyp.Dc() || rtyp.Path() != ctx.rtyp.Path()) {
+		return custom.RequestError(
+			"custom.record.type.mismatch",
+			"custom: %s record expected but %s given",
+			ctx.rtyp.Path(), rtyp.Path(),
+		)
+	}
+	return nil
+}
+
+// fetch single record of the [ctx] query result.
+func (ctx *recordQ) fetchRow(dc *pgxpool.Pool) (*custom.Record, error) {
+
+	query, args, err := ctx.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := dc.Query(ctx.req.Context, query, args...)
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+	defer rows.Close()
+
+	return customRecordFetchRow(ctx, rows)
+}
+
+// customRecordFetchRow returns the single record of the [rows] result.
+// This return (nil, NotFound) if there are none.
+func customRecordFetchRow(ctx *recordQ, rows pgx.Rows) (*custom.Record, error) {
+	var list store.RecordList
+	err := customRecordFetchRows(ctx, rows, &list)
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+	if len(list.Data) == 0 {
+		return nil, custom.NotFoundError(
+			"custom.record.not_found",
+			"custom: %s record not found",
+			ctx.rtyp.Path(),
+		)
+	}
+	return list.Data[0], nil
+}
+
+// Create new [rec]ord of the [typeOf] dictionary.
+func (c *Records) Create(ctx context.Context, typeOf customrel.DictionaryDescriptor, rec *custom.Record) (*custom.Record, error) {
+
+	if err := c.writable(typeOf); err != nil {
+		return nil, err
+	}
+	req := store.NewSearch(func(req *store.SearchOptions) {
+		req.Context = ctx
+		req.Size = 1
+	})
+	cte, err := c.query(typeOf, req)
+	if err != nil {
+		return nil, err
+	}
+	err = cte.checkRecord(rec)
+	if err != nil {
+		return nil, err
+	}
+	// [CHECK] reference(s) existence ; see custom.SetLookupResolver
+	err = rec.ResolveLookups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		columns = []string{cte.table.dc}
+		values  = []any{sq.Expr(":" + paramRecordDc)}
+	)
+	// Walk thru populated fields ONLY !
+	// [NOTE] Omitted field(s) are populated with the column DEFAULT(s).
+	var param string
+	rec.Range(func(fd customrel.FieldDescriptor, vs any) bool {
+		param, err = cte.recordValue(fd, vs)
+		if err != nil {
+			return false // break
+		}
+		columns = append(columns, CustomSqlIdentifier(fd.Name()))
+		values = append(values, sq.Expr(":"+param))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cte.CTE(CTE{
+		Name: aliasRecord,
+		Query: psql.
+			Insert(fmt.Sprintf(
+				"%s AS %s",
+				cte.table.rel.String(), aliasRecord,
+			)).
+			Columns(columns...).
+			Values(values...).
+			Suffix(fmt.Sprintf(
+				"RETURNING %s.*", aliasRecord,
+			)),
+	})
+
+	err = cte.selectFrom(aliasRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return cte.fetchRow(c.schema.primary())
+}
+
+// Get single record by it's [primary] key value.
+func (c *Records) Get(ctx context.Context, typeOf customrel.DictionaryDescriptor, id any) (*custom.Record, error) {
+
+	req := store.NewSearch(func(req *store.SearchOptions) {
+		req.Context = ctx
+		req.Size = 1
+	})
+	cte, err := c.query(typeOf, req)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := cte.primaryKey(id)
+	if err != nil {
+		return nil, err
+	}
+	cte.Params[paramRecordPk] = pk
+
+	err = cte.selectFrom(cte.table.rel.String())
+	if err != nil {
+		return nil, err
+	}
+	cte.Query = cte.Query.(SelectQ).
+		Where(fmt.Sprintf(
+			"%s.%s = :%s", aliasRecord, cte.table.dc, paramRecordDc,
+		)).
+		Where(fmt.Sprintf(
+			"%s.%s = :%s", aliasRecord,
+			CustomSqlIdentifier(typeOf.Primary().Name()),
+			paramRecordPk,
+		)).
+		Limit(1)
+
+	return cte.fetchRow(c.schema.secondary())
+}
+
+// List records of the [typeOf] dictionary.
+func (c *Records) List(typeOf customrel.DictionaryDescriptor, opts ...store.SearchOption) (*store.RecordList, error) {
+
+	cte, err := c.query(typeOf, store.NewSearch(opts...))
+	if err != nil {
+		return nil, err
+	}
+	err = customRecordSelectQuery(cte)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := cte.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	dc := c.schema.secondary()
+	rows, err := dc.Query(cte.req.Context, query, args...)
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+	defer rows.Close()
+
+	var page store.RecordList
+	err = customRecordFetchRows(cte, rows, &page)
+	if err != nil {
+		return nil, customSchemaError(err)
+	}
+	rows.Close()
+
+	if total := cte.total; total != nil && len(page.Data) == 0 {
+		// [NOTE] NO row(s) to carry the count(s) ; see searchTotal.apply(..)
+		err = total.fetchEmpty(cte.req.Context, dc, cte.statement)
+		if err != nil {
+			return nil, customSchemaError(err)
+		}
+		page.Total = total.total
+	}
+	return &page, nil
+}
+
+// Update [rec]ord field(s) been changed.
+func (c *Records) Update(ctx context.Context, typeOf customrel.DictionaryDescriptor, rec *custom.Record) (*custom.Record, error) {
+
+	if err := c.writable(typeOf); err != nil {
+		return nil, err
+	}
+	req := store.NewSearch(func(req *store.SearchOptions) {
+		req.Context = ctx
+		req.Size = 1
+	})
+	cte, err := c.query(typeOf, req)
+	if err != nil {
+		return nil, err
+	}
+	err = cte.checkRecord(rec)
+	if err != nil {
+		return nil, err
+	}
+	// [CHECK] reference(s) existence ; see custom.SetLookupResolver
+	err = rec.ResolveLookups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	primary := rec.Dataset().Primary()
+	pk, err := cte.primaryKey(rec.Get(primary))
+	if err != nil {
+		return nil, err
+	}
+	cte.Params[paramRecordPk] = pk
+
+	var (
+		param   string
+		updateQ = psql.
+			Update(fmt.Sprintf(
+				"%s AS %s",
+				cte.table.rel.String(), aliasRecord,
+			)).
+			// [FIXME]: + (OLD.* IS DISTINCT FROM NEW.*) ? 1 : 0
+			Set("ver", sq.Expr(aliasRecord+".ver + 1"))
+	)
+	// Walk thru populated fields ONLY !
+	rec.Range(func(fd customrel.FieldDescriptor, vs any) bool {
+		// omit [P]rimary [K]ey changes !
+		if fd.Name() == primary.Name() {
+			return true
+		}
+		param, err = cte.recordValue(fd, vs)
+		if err != nil {
+			return false // break
+		}
+		updateQ = updateQ.Set(
+			CustomSqlIdentifier(fd.Name()),
+			sq.Expr(":"+param),
+		)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cte.CTE(CTE{
+		Name: aliasRecord,
+		Query: updateQ.
+			Where(fmt.Sprintf(
+				"%s.%s = :%s", aliasRecord, cte.table.dc, paramRecordDc,
+			)).
+			Where(fmt.Sprintf(
+				"%s.%s = :%s", aliasRecord,
+				CustomSqlIdentifier(primary.Name()),
+				paramRecordPk,
+			)).
+			Suffix(fmt.Sprintf(
+				"RETURNING %s.*", aliasRecord,
+			)),
+	})
+
+	err = cte.selectFrom(aliasRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return cte.fetchRow(c.schema.primary())
+}
+
+// Delete record(s) by [primary] key value(s).
+func (c *Records) Delete(ctx context.Context, typeOf customrel.DictionaryDescriptor, ids ...any) (int64, error) {
+
+	if err := c.writable(typeOf); err != nil {
+		return 0, err
+	}
+	req := store.NewSearch(func(req *store.SearchOptions) {
+		req.Context = ctx
+	})
+	cte, err := c.query(typeOf, req)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil // nothing todo
+	}
+	list := make([]any, len(ids))
+	for i, id := range ids {
+		list[i], err = cte.primaryKey(id)
+		if err != nil {
+			return 0, err
+		}
+	}
+	cte.Params[paramRecordPk] = pgtype.FlatArray[any](list)
+
+	cte.Query = psql.
+		Delete(fmt.Sprintf(
+			"%s AS %s",
+			cte.table.rel.String(), aliasRecord,
+		)).
+		Where(fmt.Sprintf(
+			"%s.%s = :%s", aliasRecord, cte.table.dc, paramRecordDc,
+		)).
+		Where(fmt.Sprintf(
+			"%s.%s = ANY(:%s)", aliasRecord,
+			CustomSqlIdentifier(typeOf.Primary().Name()),
+			paramRecordPk,
+		))
+
+	query, args, err := cte.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.schema.primary().Exec(
+		cte.req.Context, query, args...,
+	)
+	if err != nil {
+		return 0, customSchemaError(err)
+	}
+	return res.RowsAffected(), nil
+}
+
+func customRecordSelectQuery(ctx *recordQ) error {
+
+	var (
+		req     = ctx.req
+		dataset = ctx.rtyp
+		fields  = dataset.Fields()
+		primary = dataset.Primary()
+	)
+
+	err := ctx.selectFrom(ctx.table.rel.String(), req.Fields...)
+	if err != nil {
+		return err
+	}
+
+	query := ctx.Query.(SelectQ).Where(fmt.Sprintf(
+		"%s.%s = :%s", aliasRecord, ctx.table.dc, paramRecordDc,
+	))
+
+	// ------- FILTER(s) -------
+	filter := make([]string, 0, len(req.Filter))
+	for name := range req.Filter {
+		filter = append(filter, name)
+	}
+	// [NOTE] deterministic parameter(s) order
+	slices.Sort(filter)
+	where := fieldFilter{
+		rel:    aliasRecord,
+		scope:  "custom.record",
+		prefix: "f",
+		params: Parameters(ctx.Params),
+	}
+	for _, name := range filter {
+		fd := fields.ByName(name)
+		if fd == nil {
+			return custom.RequestError(
+				"custom.record.filter.field.not_found",
+				"custom: %s{%s} no such field",
+				dataset.Path(), name,
+			)
+		}
+		pred, err := where.where(fd, req.Filter[name])
+		if err != nil {
+			return err
+		}
+		query = query.Where(pred)
+	}
+
+	// ------- FILTER expression -------
+	if req.Expr != nil {
+		err = req.Expr.Check(dataset)
+		if err != nil {
+			return err
+		}
+		where := fieldFilter{
+			rel:    aliasRecord,
+			scope:  "custom.record",
+			prefix: "q",
+			params: Parameters(ctx.Params),
+		}
+		pred, err := where.expr(dataset, req.Expr)
+		if err != nil {
+			return err
+		}
+		query = query.Where(pred)
+	}
+
+	// [NOTE] result item(s) ; NO sort, limit and cursor position applied
+	items := query
+
+	// ------- SORT(s) -------
+	keys, err := newKeyset(&req)
+	if err != nil {
+		return err
+	}
+	var (
+		sort = names{}
+		desc bool
+	)
+	for _, spec := range req.Sort {
+		desc = false
+		switch spec[0] {
+		case '-':
+			desc = true
+			spec = spec[1:]
+		case '+':
+			spec = spec[1:]
+		}
+		fd := fields.ByName(spec)
+		if fd == nil {
+			return custom.RequestError(
+				"custom.record.sort.field.not_found",
+				"custom: %s{%s} no such field",
+				dataset.Path(), spec,
+			)
+		}
+		if !sort.append(fd.Name()) {
+			continue // duplicate
+		}
+		keys.add(fd.Name(), fmt.Sprintf(
+			"%s.%s", aliasRecord,
+			CustomSqlIdentifier(fd.Name()),
+		), desc)
+	}
+	// [NOTE] ORDER BY [primary] as the last resort; stable paging !
+	if sort.append(primary.Name()) {
+		keys.add(primary.Name(), fmt.Sprintf(
+			"%s.%s", aliasRecord,
+			CustomSqlIdentifier(primary.Name()),
+		), false)
+	}
+
+	// ------- PAGING --------
+	if size := req.GetSize(); size > 0 {
+		// keyset ; cursor position
+		query, err = keys.apply(query, ctx.Params)
+		if err != nil {
+			return err
+		}
+		ctx.keys = keys
+		ctx.plan = append(ctx.plan, func(*custom.Record) sql.Scanner {
+			return keys.scan()
+		})
+		// OFFSET (page-1)*size -- omit same-sized previous page(s) from result
+		if page := req.GetPage(); page > 1 && keys.cursor == nil {
+			query = query.Offset((uint64)((page - 1) * (size)))
+		}
+		// LIMIT (size+1) -- to indicate whether there are more result entries
+		query = query.Limit((uint64)(size + 1))
+	} else {
+		for _, key := range keys.keys {
+			order := "ASC"
+			if key.desc {
+				order = "DESC"
+			}
+			query = query.OrderBy(key.expr + " " + order)
+		}
+	}
+
+	// ------- TOTAL(s) -------
+	if total := newSearchTotal(&req); total != nil {
+		for _, name := range req.Facets {
+			fd := fields.ByName(name)
+			if fd == nil {
+				return custom.RequestError(
+					"custom.record.facet.field.not_found",
+					"custom: %s{%s} no such field",
+					dataset.Path(), name,
+				)
+			}
+			var (
+				column = sqlident{aliasRecord, CustomSqlIdentifier(fd.Name())}.String()
+				title  string
+			)
+			switch fd.Kind() {
+			case customrel.LIST, customrel.RECORD:
+				return custom.RequestError(
+					"custom.record.facet.field.invalid",
+					"custom: %s{%s} facet of the %s field is not supported",
+					dataset.Path(), name, fd.Kind(),
+				)
+			case customrel.LOOKUP:
+				ref := fd.Type().(*custom.Lookup).Dictionary()
+				title, err = customLookupName(ref, aliasRecord, column)
+				if err != nil {
+					return err
+				}
+			}
+			total.facet(fd.Name(), column, title)
+		}
+		var scan []sql.Scanner
+		query, scan, err = total.apply(query, items, ctx.table.rel, keys.cursor == nil)
+		if err != nil {
+			return err
+		}
+		ctx.total = total
+		for _, col := range scan {
+			ctx.plan = append(ctx.plan, func(*custom.Record) sql.Scanner {
+				return col
+			})
+		}
+	}
+
+	ctx.Query = query
+	return nil
+}
+
+func customRecordFetchRows(ctx *recordQ, rows pgx.Rows, into *store.RecordList) error {
+
+	var (
+		err   error
+		req   = ctx.req
+		plan  = ctx.plan
+		row   *custom.Record           // active record
+		scan  = make([]any, len(plan)) // columns bound
+		data  []*custom.Record         // output data
+		limit = req.GetSize()          // limit count
+	)
+
+	into.Page = req.GetPage()
+	if 0 < limit {
+		data = make([]*custom.Record, 0, limit)
+	}
+
+	// FETCH
+	for rows.Next() {
+		// LIMIT
+		if 0 < limit && len(data) == limit {
+			// mark: more(!) record(s) available
+			into.Next = true
+			if into.Page < 1 {
+				into.Page = 1 // default
+			}
+			break // rows.Next()
+		}
+		// RECORD
+		row = custom.NewRecord(ctx.rtyp)
+		// BIND RECORD
+		for c, bind := range plan {
+			scan[c] = bind(row)
+		}
+		// DECODE
+		err = rows.Scan(scan...)
+		if err != nil {
+			break // rows.Next()
+		}
+		// RESULT
+		data = append(data, row)
+	}
+
+	if err == nil {
+		err = rows.Err()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if total := ctx.total; total != nil {
+		into.Total = total.total
+		into.Estimated = (total.mode == store.TotalEstimate)
+		into.Facets = total.facets
+	}
+	if keys := ctx.keys; keys != nil {
+		if into.Next {
+			into.Cursor = keys.token()
+		}
+		if keys.before {
+			// [NOTE] fetched in reverse order
+			slices.Reverse(data)
+		}
+	}
+	if !into.Next && into.Page <= 1 {
+		// The first page with NO more results !
+		into.Page = 0 // Hide: NO paging !
+	}
+	into.Data = data
+	return nil
+}
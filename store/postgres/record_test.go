@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	custom "github.com/webitel/custom/data"
+	"github.com/webitel/custom/store"
+	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
+)
+
+// emptyRows is the pgx.Rows result with NO row(s).
+type emptyRows struct{}
+
+func (emptyRows) Close()                                       {}
+func (emptyRows) Err() error                                   { return nil }
+func (emptyRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (emptyRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (emptyRows) Next() bool                                   { return false }
+func (emptyRows) Scan(...any) error                            { return pgx.ErrNoRows }
+func (emptyRows) Values() ([]any, error)                       { return nil, nil }
+func (emptyRows) RawValues() [][]byte                          { return nil }
+func (emptyRows) Conn() *pgx.Conn                              { return nil }
+
+func TestRecords(t *testing.T) {
+	spec := func(repo, path string) *custompb.Dataset {
+		return &custompb.Dataset{
+			Repo:    repo,
+			Path:    path,
+			Primary: "id",
+			Display: "name",
+			Fields: []*custompb.Field{
+				{Id: "id", Kind: datapb.Kind_int64},
+				{Id: "name", Kind: datapb.Kind_string},
+			},
+		}
+	}
+	var (
+		c      = &Records{}
+		ctx    = context.Background()
+		global = custom.DictionaryOf(0, spec("calendars", "calendars")) // [ GLOBAL ] ; readonly
+		cities = custom.DictionaryOf(1, spec("cities", "dictionaries/cities"))
+	)
+	errorId := func(err error) string {
+		var re *custom.Error
+		if errors.As(err, &re) {
+			return re.Id
+		}
+		return ""
+	}
+
+	// [ GLOBAL ] records are readable ..
+	_, err := c.query(global, store.NewSearch())
+	if got := errorId(err); got != "custom.dictionary.domain.required" {
+		t.Errorf("Records.query(global) error = %v, want custom.dictionary.domain.required", err)
+	}
+	list, err := c.query(global, store.NewSearch(func(req *store.SearchOptions) {
+		req.Dc = 1
+	}))
+	if err != nil {
+		t.Fatalf("Records.query(global, dc: 1) error = %v", err)
+	}
+	err = customRecordSelectQuery(list)
+	if err != nil {
+		t.Fatalf("customRecordSelectQuery(global) error = %v", err)
+	}
+	query, _, _ := list.ToSql()
+	if !strings.Contains(query, "FROM flow.calendar AS e WHERE e.domain_id = $") {
+		t.Errorf("customRecordSelectQuery(global) = %s ; want the domain_id restriction", query)
+	}
+	// .. but NOT writable
+	_, err = c.Create(ctx, global, custom.NewRecord(global))
+	if got := errorId(err); got != "custom.dictionary.readonly" {
+		t.Errorf("Records.Create(global) error = %v, want custom.dictionary.readonly", err)
+	}
+	_, err = c.Update(ctx, global, custom.NewRecord(global))
+	if got := errorId(err); got != "custom.dictionary.readonly" {
+		t.Errorf("Records.Update(global) error = %v, want custom.dictionary.readonly", err)
+	}
+	_, err = c.Delete(ctx, global, 1)
+	if got := errorId(err); got != "custom.dictionary.readonly" {
+		t.Errorf("Records.Delete(global) error = %v, want custom.dictionary.readonly", err)
+	}
+
+	// FILTER(s) ; see fieldFilter
+	find, err := c.query(cities, store.NewSearch(func(req *store.SearchOptions) {
+		req.Filter = map[string]any{"name": "Ky*", "id": "1"}
+	}))
+	if err != nil {
+		t.Fatalf("Records.query(cities, filter) error = %v", err)
+	}
+	if err = customRecordSelectQuery(find); err != nil {
+		t.Fatalf("customRecordSelectQuery(filter) error = %v", err)
+	}
+	query, _, _ = find.ToSql()
+	if !strings.Contains(query, "WHERE e.dc = $1 AND e.id = $2 AND e.name ILIKE $3") {
+		t.Errorf("customRecordSelectQuery(filter) = %s ; want the field(s) assertion", query)
+	}
+	find, _ = c.query(cities, store.NewSearch(func(req *store.SearchOptions) {
+		req.Filter = map[string]any{"title": "Kyiv"}
+	}))
+	err = customRecordSelectQuery(find)
+	if got := errorId(err); got != "custom.record.filter.field.not_found" {
+		t.Errorf("customRecordSelectQuery(title) error = %v, want custom.record.filter.field.not_found", err)
+	}
+
+	// NOT FOUND ; see Records.Get, Records.Update
+	get, err := c.query(cities, store.NewSearch())
+	if err != nil {
+		t.Fatalf("Records.query(cities) error = %v", err)
+	}
+	err = get.selectFrom(get.table.rel.String())
+	if err != nil {
+		t.Fatalf("recordQ.selectFrom() error = %v", err)
+	}
+	rec, err := customRecordFetchRow(get, emptyRows{})
+	var re *custom.Error
+	if rec != nil || !errors.As(err, &re) || re.Id != "custom.record.not_found" || re.Code != 404 {
+		t.Errorf("customRecordFetchRow(none) = (%v, %v), want (nil, custom.record.not_found)", rec, err)
+	}
+}
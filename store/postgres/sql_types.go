@@ -4,8 +4,10 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
+	"time"
 
 	// proto1 "github.com/golang/protobuf/proto"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -13,6 +15,7 @@ import (
 	custom "github.com/webitel/custom/data"
 	customrel "github.com/webitel/custom/reflect"
 	custompb "github.com/webitel/proto/gen/custom"
+	datapb "github.com/webitel/proto/gen/custom/data"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -530,6 +533,47 @@ func CustomTypeSqlValue(dt customrel.Type, vs any) (v any, err error) {
 			vs = rv.Interface() // NULL(-able) !
 			// process as indirect below !
 		}
+	case *custom.Record:
+		{
+			if v == nil {
+				// NULL
+				return nil, nil
+			}
+			return v.AsMap(), nil // jsonb
+		}
+	case []byte:
+		{
+			if v == nil {
+				// NULL
+				return nil, nil
+			}
+			// bytea
+			return v, nil
+		}
+	case *time.Time:
+		{
+			if v == nil {
+				// NULL
+				return nil, nil
+			}
+			dt, _ := dt.(*custom.DateTime)
+			switch dt.Part() {
+			case datapb.Datetime_date:
+				return v.Format(time.DateOnly), nil
+			case datapb.Datetime_time:
+				return v.Format("15:04:05.999999999"), nil
+			}
+			// process as indirect below !
+		}
+	case *big.Rat:
+		{
+			if v == nil {
+				// NULL
+				return nil, nil
+			}
+			// numeric ; exact decimal string
+			return dt.(*custom.Decimal).Format(v), nil
+		}
 	}
 	// reflect
 	rv := reflect.ValueOf(vs)
@@ -607,9 +651,22 @@ func customTypeName(typ customrel.Type) (name string) {
 	case customrel.RICHTEXT:
 		name = "text"
 	case customrel.DATETIME:
-		name = "timestamp" // [ without time zone ]
+		switch typ.(*custom.DateTime).Part() {
+		case datapb.Datetime_date:
+			name = "date"
+		case datapb.Datetime_time:
+			name = "time" // [ without time zone ]
+		default:
+			name = "timestamptz" // [ with time zone ]
+		}
 	case customrel.DURATION:
 		name = "interval"
+	case customrel.RECORD:
+		name = "jsonb" // nested structure
+	case customrel.ENUM:
+		name = "text" // option code
+	case customrel.DECIMAL, customrel.MONEY:
+		name = "numeric" // [ (precision, scale) ] ; see customColumnType
 	// case customrel.NONE:
 	default:
 		name = "text" // [FIXME] !!!
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/custom/store"
+	custompb "github.com/webitel/proto/gen/custom"
+)
+
+// searchTotal of the search query result ; the total count and the facet count(s).
+type searchTotal struct {
+	mode   store.SearchTotal
+	keys   []searchFacet
+	total  int64
+	facets []*custompb.Facet
+	// standalone count(s) query of the empty page,
+	// that has NO row(s) to carry the count column(s)
+	empty SelectQ
+	scan  []sql.Scanner
+}
+
+// searchFacet is the group key of the result item(s).
+type searchFacet struct {
+	field string // name
+	value string // sql ; group key
+	name  string // sql ; display name, if lookup ; optional
+}
+
+// newSearchTotal returns the [req]uested total count options, if any.
+func newSearchTotal(req *store.SearchOptions) *searchTotal {
+	if req.Total == store.TotalNone && len(req.Facets) == 0 {
+		return nil
+	}
+	return &searchTotal{mode: req.Total}
+}
+
+// facet adds the [field] group key [value] expression and it's display [name], if any.
+func (c *searchTotal) facet(field, value, name string) {
+	for _, key := range c.keys {
+		if key.field == field {
+			return // duplicate
+		}
+	}
+	c.keys = append(c.keys, searchFacet{
+		field: field, value: value, name: name,
+	})
+}
+
+// apply appends the total count and the facet column(s) to the [query].
+//
+// The [from] is the result item(s) query with NO sort, limit and cursor position applied, yet.
+// The [table] is the dataset relation to be estimated. The [window] indicates whether
+// the count(*) OVER() is applicable, i.e. the [query] has NO cursor position.
+// Returns the column(s) scanner(s), in order.
+func (c *searchTotal) apply(query, from SelectQ, table sqlident, window bool) (SelectQ, []sql.Scanner, error) {
+	var scan []sql.Scanner
+	c.empty = psql.Select()
+	switch c.mode {
+	case store.TotalExact:
+		count, _, err := from.RemoveColumns().Column("count(*)").
+			Prefix("(").Suffix(")").ToSql()
+		if err != nil {
+			return query, nil, err
+		}
+		c.empty = c.empty.Column(count)
+		if window {
+			count = "count(*) OVER()"
+		}
+		query = query.Column(count)
+	case store.TotalEstimate:
+		// [NOTE] reltuples is -1 if the table has never yet been analyzed
+		estimate := fmt.Sprintf(
+			"(SELECT GREATEST(c.reltuples,0)::::bigint FROM pg_catalog.pg_class c WHERE c.oid = to_regclass(%s))",
+			customSqlLiteral(table.String()),
+		)
+		c.empty = c.empty.Column(estimate)
+		query = query.Column(estimate)
+	}
+	if c.mode != store.TotalNone {
+		scan = append(scan, ScanFunc(func(src any) error {
+			c.total, _ = src.(int64)
+			return nil
+		}))
+	}
+	for i, key := range c.keys {
+		name := key.name
+		if name == "" {
+			name = "NULL"
+		}
+		group, _, err := from.RemoveColumns().
+			Column(fmt.Sprintf("(%s)::::text AS v", key.value)).
+			Column(fmt.Sprintf("(%s)::::text AS n", name)).
+			Column("count(*) AS c").
+			GroupBy("1", "2").ToSql()
+		if err != nil {
+			return query, nil, err
+		}
+		counts := fmt.Sprintf(
+			"(SELECT json_agg(json_build_object('value',f.v,'name',f.n,'count',f.c)ORDER BY f.c DESC,f.v)FROM(%s)f)::::text",
+			group,
+		)
+		c.empty = c.empty.Column(counts)
+		query = query.Column(counts)
+		facet := &custompb.Facet{Field: key.field}
+		c.facets = append(c.facets, facet)
+		scan = append(scan, c.scanFacet(i))
+	}
+	c.scan = scan
+	return query, scan, nil
+}
+
+// fetchEmpty fetches the count(s) of the empty page, e.g.: OFFSET past the last page,
+// which has NO row(s) to carry the count column(s). The [stmt] is the page query
+// statement, whose WITH clause and parameter(s) the count(s) query shares.
+func (c *searchTotal) fetchEmpty(ctx context.Context, dc *pgxpool.Pool, stmt statement) error {
+	stmt.Query = c.empty
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return err
+	}
+	scan := make([]any, len(c.scan))
+	for i, col := range c.scan {
+		scan[i] = col
+	}
+	return dc.QueryRow(ctx, query, args...).Scan(scan...)
+}
+
+// scanFacet returns the JSON facet counts column scanner of the [i]th key.
+func (c *searchTotal) scanFacet(i int) sql.Scanner {
+	return ScanFunc(func(src any) error {
+		text, _ := src.(string)
+		if data, is := src.([]byte); is {
+			text = string(data)
+		}
+		var list []struct {
+			Value *string `json:"value"`
+			Name  *string `json:"name"`
+			Count int64   `json:"count"`
+		}
+		if text != "" {
+			err := json.Unmarshal([]byte(text), &list)
+			if err != nil {
+				return err
+			}
+		}
+		counts := make([]*custompb.FacetCount, len(list))
+		for n, group := range list {
+			count := &custompb.FacetCount{Count: group.Count}
+			if group.Value != nil {
+				count.Value = *group.Value
+			}
+			if group.Name != nil {
+				count.Name = *group.Name
+			}
+			counts[n] = count
+		}
+		c.facets[i].Counts = counts
+		return nil
+	})
+}
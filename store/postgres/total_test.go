@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/webitel/custom/store"
+)
+
+func Test_searchTotal(t *testing.T) {
+	var (
+		table = sqlident{"custom", "d1_cities"}
+		items = psql.Select("e.id").From("custom.d1_cities e").Where("e.dc = :dc")
+	)
+	tests := []struct {
+		mode   store.SearchTotal
+		window bool
+		want   string
+		empty  string
+	}{
+		{
+			store.TotalExact, true,
+			"SELECT e.id, count(*) OVER() FROM custom.d1_cities e WHERE e.dc = :dc",
+			"SELECT ( SELECT count(*) FROM custom.d1_cities e WHERE e.dc = :dc )",
+		},
+		{
+			store.TotalExact, false,
+			"SELECT e.id, ( SELECT count(*) FROM custom.d1_cities e WHERE e.dc = :dc ) FROM custom.d1_cities e WHERE e.dc = :dc",
+			"SELECT ( SELECT count(*) FROM custom.d1_cities e WHERE e.dc = :dc )",
+		},
+		{
+			store.TotalEstimate, false,
+			"SELECT e.id, (SELECT GREATEST(c.reltuples,0)::::bigint FROM pg_catalog.pg_class c " +
+				"WHERE c.oid = to_regclass('custom.d1_cities')) FROM custom.d1_cities e WHERE e.dc = :dc",
+			"SELECT (SELECT GREATEST(c.reltuples,0)::::bigint FROM pg_catalog.pg_class c " +
+				"WHERE c.oid = to_regclass('custom.d1_cities'))",
+		},
+	}
+	for _, tt := range tests {
+		total := newSearchTotal(&store.SearchOptions{Total: tt.mode})
+		query, scan, err := total.apply(items, items, table, tt.window)
+		if err != nil {
+			t.Fatalf("searchTotal.apply() error = %v", err)
+		}
+		if got, _, _ := query.ToSql(); got != tt.want || len(scan) != 1 {
+			t.Errorf("searchTotal.apply() =\n%s\nwant:\n%s", got, tt.want)
+		}
+		// [NOTE] the empty page ; e.g.: OFFSET past the last page
+		if got, _, _ := total.empty.ToSql(); got != tt.empty || len(total.scan) != 1 {
+			t.Errorf("searchTotal.empty =\n%s\nwant:\n%s", got, tt.empty)
+		}
+		_ = scan[0].Scan(int64(2340))
+		if total.total != 2340 {
+			t.Errorf("searchTotal.total = %d, want 2340", total.total)
+		}
+	}
+
+	if newSearchTotal(&store.SearchOptions{}) != nil {
+		t.Errorf("newSearchTotal() = not nil, want nil ; not requested")
+	}
+	total := newSearchTotal(&store.SearchOptions{Facets: []string{"region"}})
+	total.facet("region", "e.region", "")
+	total.facet("region", "e.region", "") // duplicate
+	query, scan, err := total.apply(items, items, table, true)
+	if err != nil {
+		t.Fatalf("searchTotal.apply() error = %v", err)
+	}
+	want := "SELECT e.id, (SELECT json_agg(json_build_object('value',f.v,'name',f.n,'count',f.c)ORDER BY f.c DESC,f.v)" +
+		"FROM(SELECT (e.region)::::text AS v, (NULL)::::text AS n, count(*) AS c FROM custom.d1_cities e WHERE e.dc = :dc GROUP BY 1, 2)f)::::text " +
+		"FROM custom.d1_cities e WHERE e.dc = :dc"
+	if got, _, _ := query.ToSql(); got != want || len(scan) != 1 {
+		t.Errorf("searchTotal.apply(facets) =\n%s\nwant:\n%s", got, want)
+	}
+	want = "SELECT (SELECT json_agg(json_build_object('value',f.v,'name',f.n,'count',f.c)ORDER BY f.c DESC,f.v)" +
+		"FROM(SELECT (e.region)::::text AS v, (NULL)::::text AS n, count(*) AS c FROM custom.d1_cities e WHERE e.dc = :dc GROUP BY 1, 2)f)::::text"
+	if got, _, _ := total.empty.ToSql(); got != want {
+		t.Errorf("searchTotal.empty(facets) =\n%s\nwant:\n%s", got, want)
+	}
+	// the empty page statement shares the page query parameter(s)
+	stmt := statement{Query: total.empty, Params: map[string]any{"dc": int64(1)}}
+	if _, args, err := stmt.ToSql(); err != nil || len(args) != 1 {
+		t.Errorf("searchTotal.empty(facets) args = %v, error = %v ; want [1]", args, err)
+	}
+	err = scan[0].Scan(`[{"value":"north","name":null,"count":12},{"value":null,"name":null,"count":3}]`)
+	if err != nil {
+		t.Fatalf("searchTotal.scanFacet() error = %v", err)
+	}
+	if len(total.facets) != 1 || total.facets[0].GetField() != "region" || len(total.facets[0].GetCounts()) != 2 {
+		t.Fatalf("searchTotal.facets = %v", total.facets)
+	}
+	if c := total.facets[0].GetCounts()[0]; c.GetValue() != "north" || c.GetCount() != 12 {
+		t.Errorf("searchTotal.facets[0] = %v, want {north 12}", c)
+	}
+}
@@ -1,6 +1,10 @@
 package store
 
-import "context"
+import (
+	"context"
+
+	"github.com/webitel/custom/data"
+)
 
 type SearchOptions struct {
 	// Context
@@ -12,12 +16,34 @@ type SearchOptions struct {
 	Size   int
 	Sort   []string
 	Fields []string
+	// Keyset pagination ; the [Page] is ignored.
+	// Opaque cursor token of the result item
+	// to continue the search [After] -OR- [Before] ; see Cursor.
+	After, Before string
+	// Total count of the result item(s), if requested.
+	Total SearchTotal
+	// Facets are the field name(s) to count the result item(s) grouped by.
+	Facets []string
 	// Request
 	Filter map[string]any
+	// Request filter expression, if any ; AND [Filter]
+	Expr FilterExpr
 }
 
 type SearchOption func(req *SearchOptions)
 
+// SearchTotal mode of the result item(s) total count.
+type SearchTotal int
+
+const (
+	// TotalNone ; not requested.
+	TotalNone SearchTotal = iota
+	// TotalExact count of the result item(s) matching the filter(s).
+	TotalExact
+	// TotalEstimate count of the dataset item(s) according to the database statistics ; regardless of the filter(s).
+	TotalEstimate
+)
+
 // NewSearch builds new search request options
 func NewSearch(opts ...SearchOption) SearchOptions {
 	req := SearchOptions{
@@ -51,6 +77,26 @@ func (req *SearchOptions) GetSize() int {
 	panic("unreachable code")
 }
 
+// GetCursor returns the keyset pagination position, if any.
+// The [before] indicates the backward search direction.
+func (req *SearchOptions) GetCursor() (pos *Cursor, before bool, err error) {
+	if req == nil || (req.After == "" && req.Before == "") {
+		return nil, false, nil
+	}
+	if req.After != "" && req.Before != "" {
+		return nil, false, data.RequestError(
+			"custom.search.cursor.invalid",
+			"custom: search( after, before ); either one expected",
+		)
+	}
+	token, before := req.After, false
+	if token == "" {
+		token, before = req.Before, true
+	}
+	pos, err = ParseCursor(token)
+	return pos, before, err
+}
+
 func (req *SearchOptions) GetPage() int {
 	if req != nil {
 		// Limited ? either: manual -or- default !
@@ -1,9 +1,60 @@
 package store
 
 import (
+	"context"
+
+	"github.com/webitel/custom/data"
+	customrel "github.com/webitel/custom/reflect"
 	custompb "github.com/webitel/proto/gen/custom"
 )
 
 type Catalog interface {
 	Search(opts ...SearchOption) (*custompb.DatasetList, error)
+	// Create new [CUSTOM] dataset type on behalf of the [uid] user.
+	// Returns the type, as it was stored.
+	Create(ctx context.Context, uid int64, typeOf customrel.DatasetDescriptor) (*custompb.Dataset, error)
+	// Update [CUSTOM] dataset type structure on behalf of the [uid] user.
+	// The type is identified by it's [dc] and [path].
+	// This return (nil, NotFound) if not found.
+	Update(ctx context.Context, uid int64, typeOf customrel.DatasetDescriptor) (*custompb.Dataset, error)
+	// Delete [CUSTOM] dataset type(s) of the [dc] domain by relative [path](s).
+	// Returns the number of types affected.
+	Delete(ctx context.Context, dc int64, path ...string) (int64, error)
+}
+
+// RecordList is a page of the dataset records.
+type RecordList struct {
+	// List of the dataset records.
+	Data []*data.Record
+	// Page number of the results.
+	Page int
+	// Next page available ?
+	Next bool
+	// Cursor of the boundary record to continue the search
+	// in the same direction, if [Next] page available ; see SearchOptions.After.
+	Cursor string
+	// Total count of the records, if requested ; see SearchOptions.Total.
+	Total int64
+	// The [Total] count is estimated ?
+	Estimated bool
+	// Facet counts of the records, if requested ; see SearchOptions.Facets.
+	Facets []*custompb.Facet
+}
+
+// Records of the [CUSTOM] dictionary dataset.
+type Records interface {
+	// Create new [rec]ord of the [typeOf] dictionary.
+	// Returns the record, as it was stored, with generated field(s) populated.
+	Create(ctx context.Context, typeOf customrel.DictionaryDescriptor, rec *data.Record) (*data.Record, error)
+	// Get single record by it's [primary] key value.
+	// This return (nil, NotFound) if not found.
+	Get(ctx context.Context, typeOf customrel.DictionaryDescriptor, id any) (*data.Record, error)
+	// List records of the [typeOf] dictionary.
+	List(typeOf customrel.DictionaryDescriptor, opts ...SearchOption) (*RecordList, error)
+	// Update [rec]ord field(s) been changed. The [primary] key value required.
+	// This return (nil, NotFound) if not found.
+	Update(ctx context.Context, typeOf customrel.DictionaryDescriptor, rec *data.Record) (*data.Record, error)
+	// Delete record(s) by [primary] key value(s).
+	// Returns the number of records affected.
+	Delete(ctx context.Context, typeOf customrel.DictionaryDescriptor, ids ...any) (int64, error)
 }